@@ -0,0 +1,394 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CSV header modes.
+const (
+	headerAuto = "auto"
+	headerYes  = "yes"
+	headerNo   = "no"
+)
+
+// CSV file encodings.
+const (
+	encodingUTF8        = "utf-8"
+	encodingWindows1251 = "windows-1251"
+)
+
+// annotationColumns are the names of columns appended to every INN column.
+var annotationColumns = []string{"inn_valid", "inn_kind", "inn_error"} //nolint:gochecknoglobals
+
+var (
+	// errCSVColumn is an error for unknown or invalid INN columns.
+	errCSVColumn = errors.New("invalid CSV column")
+	// errCSVOption is an error for invalid CSV options.
+	errCSVOption = errors.New("invalid CSV option")
+)
+
+// csvColumn is a CSV column with INNs, index is 0-based.
+type csvColumn struct {
+	name  string
+	index int
+}
+
+// csvCheck validates INN columns of CSV records and appends the annotation fields,
+// width is the number of fields in the first record.
+type csvCheck struct {
+	strict  bool
+	columns []csvColumn
+	width   int
+	valid   int
+	invalid int
+}
+
+// annotate returns the record with appended validity, kind and error of every INN column,
+// the fields are empty for empty INNs. Short records are padded to the first record width,
+// so the annotation fields stay under their header.
+func (c *csvCheck) annotate(record []string) []string {
+	if len(record) < c.width {
+		record = append(record, make([]string, c.width-len(record))...)
+	}
+
+	for _, column := range c.columns {
+		value := ""
+		if column.index < len(record) {
+			value = strings.TrimSpace(record[column.index])
+		}
+
+		if value == "" {
+			record = append(record, "", "", "")
+			continue
+		}
+
+		r := newResult(value, validateINN(value, c.strict))
+		if r.Valid {
+			c.valid++
+		} else {
+			c.invalid++
+		}
+
+		record = append(record, strconv.FormatBool(r.Valid), string(r.Kind), r.Error)
+	}
+	return record
+}
+
+// header returns the header with appended annotation columns,
+// they are prefixed by the INN column name if there are several INN columns.
+func (c *csvCheck) header(record []string) []string {
+	for _, column := range c.columns {
+		for _, name := range annotationColumns {
+			if len(c.columns) > 1 {
+				name = column.name + "_" + name
+			}
+			record = append(record, name)
+		}
+	}
+	return record
+}
+
+// runCheckCSV validates INN columns of a CSV file and writes it with appended annotation columns.
+func runCheckCSV(args []string) error {
+	fs := newFlagSet(
+		"check-csv", "<file>",
+		"Validates INN columns of a CSV file (\"-\" is stdin) and writes the CSV\n"+
+			"with appended inn_valid, inn_kind and inn_error columns.",
+	)
+	columns := fs.String("columns", "inn", "comma-separated names or 1-based numbers of INN columns")
+	delimiter := fs.String("d", ",", "field delimiter, \"tab\" is a tab character")
+	header := fs.String("header", headerAuto, "header row: auto, yes or no")
+	encoding := fs.String("encoding", encodingUTF8, "file encoding: utf-8 or windows-1251, the output has the same encoding")
+	lazyQuotes := fs.Bool("lazy-quotes", false, "allow quotes in unquoted fields and non-doubled quotes in quoted fields")
+	strict := fs.Bool("strict", false, "reject tax office codes which do not exist in regions with complete registry lists")
+	output := fs.String("out", "", "output file, stdout if not set, it must not be the input file")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return usageError(fs, "expected one CSV file, got %d arguments", fs.NArg())
+	}
+
+	comma, err := parseDelimiter(*delimiter)
+	if err != nil {
+		return usageError(fs, "%v", err)
+	}
+
+	if !slices.Contains([]string{headerAuto, headerYes, headerNo}, *header) {
+		return usageError(fs, "%v: header mode %q, expected auto, yes or no", errCSVOption, *header)
+	}
+
+	enc, err := parseEncoding(*encoding)
+	if err != nil {
+		return usageError(fs, "%v", err)
+	}
+
+	if sameFile(fs.Arg(0), *output) {
+		// the output file is truncated before the input is read
+		return usageError(fs, "%v: output file %q is the input file", errCSVOption, *output)
+	}
+
+	src, closeSrc, err := openInput(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer closeSrc()
+
+	dst, closeDst, err := openOutput(*output)
+	if err != nil {
+		return err
+	}
+
+	check := &csvCheck{strict: *strict}
+	err = checkCSV(check, src, dst, &csvOptions{
+		comma: comma, header: *header, encoding: enc, lazyQuotes: *lazyQuotes, columns: *columns,
+	})
+	if closeErr := closeDst(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, checkedSummary(check.valid, check.invalid))
+	if check.invalid > 0 {
+		return errInvalid
+	}
+	return nil
+}
+
+// csvOptions are the options of CSV reading and writing.
+type csvOptions struct {
+	comma      rune
+	header     string
+	encoding   string
+	lazyQuotes bool
+	columns    string
+}
+
+// checkCSV reads CSV records from src, annotates them and writes them to dst.
+func checkCSV(check *csvCheck, src io.Reader, dst io.Writer, opts *csvOptions) error {
+	in := bufio.NewReader(src)
+	out := bufio.NewWriter(dst)
+
+	var (
+		r       io.Reader = in
+		w       io.Writer = out
+		cp1251W *windows1251Writer
+	)
+	if opts.encoding == encodingWindows1251 {
+		cp1251W = newWindows1251Writer(out)
+		r, w = newWindows1251Reader(in), cp1251W
+	} else {
+		if bom, _ := in.Peek(len(byteOrderMark)); string(bom) == byteOrderMark {
+			// keep BOM for spreadsheet applications
+			_, _ = in.Discard(len(byteOrderMark))
+			if _, err := out.WriteString(byteOrderMark); err != nil {
+				return err
+			}
+		}
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma, reader.LazyQuotes, reader.FieldsPerRecord = opts.comma, opts.lazyQuotes, -1
+
+	writer := csv.NewWriter(w)
+	writer.Comma = opts.comma
+
+	for n := 0; ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading CSV: %w", err)
+		}
+
+		if n == 0 {
+			err = writeFirstRecord(check, writer, record, opts)
+		} else {
+			err = writer.Write(check.annotate(record))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	if cp1251W != nil {
+		if err := cp1251W.Flush(); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// writeFirstRecord resolves INN columns by the first record and writes it as a header or an annotated record.
+func writeFirstRecord(check *csvCheck, writer *csv.Writer, first []string, opts *csvOptions) error {
+	hasHeader, columns, err := resolveColumns(first, opts.columns, opts.header)
+	if err != nil {
+		return err
+	}
+
+	check.columns, check.width = columns, len(first)
+	if hasHeader {
+		return writer.Write(check.header(first))
+	}
+	return writer.Write(check.annotate(first))
+}
+
+// resolveColumns detects the header in the first record and returns INN columns by their names or numbers.
+// In the auto mode the first record is a header if it contains named columns
+// or if the numbered columns have no digits.
+func resolveColumns(first []string, spec, mode string) (bool, []csvColumn, error) {
+	names := strings.Split(spec, ",")
+	columns := make([]csvColumn, 0, len(names))
+	named := false
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if n, err := strconv.Atoi(name); err == nil {
+			if n < 1 {
+				return false, nil, fmt.Errorf("%w: column number %d, it starts from 1", errCSVColumn, n)
+			}
+			columns = append(columns, csvColumn{name: "column" + name, index: n - 1})
+			continue
+		}
+
+		if name == "" {
+			return false, nil, fmt.Errorf("%w: empty column name in %q", errCSVColumn, spec)
+		}
+
+		named = true
+		columns = append(columns, csvColumn{name: name, index: -1})
+	}
+
+	hasHeader := mode == headerYes
+	if mode == headerAuto {
+		hasHeader = named || !hasDigits(first, columns)
+	}
+
+	if named && !hasHeader {
+		return false, nil, fmt.Errorf("%w: columns can be set only by numbers without a header", errCSVColumn)
+	}
+
+	if !hasHeader {
+		return false, columns, nil
+	}
+
+	for i, column := range columns {
+		if column.index >= 0 {
+			if column.index < len(first) {
+				columns[i].name = strings.TrimSpace(first[column.index])
+			}
+			continue
+		}
+
+		index := slices.IndexFunc(first, func(field string) bool {
+			return strings.EqualFold(strings.TrimSpace(field), column.name)
+		})
+		if index < 0 {
+			return false, nil, fmt.Errorf("%w: column %q is not found in the header", errCSVColumn, column.name)
+		}
+		columns[i].index = index
+	}
+
+	return true, columns, nil
+}
+
+// hasDigits returns true if any of the record columns contains a digit.
+func hasDigits(record []string, columns []csvColumn) bool {
+	for _, column := range columns {
+		if column.index < len(record) && strings.ContainsFunc(record[column.index], unicode.IsDigit) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDelimiter returns a CSV delimiter, "tab" and "\t" are a tab character.
+func parseDelimiter(value string) (rune, error) {
+	if value == "tab" || value == `\t` {
+		return '\t', nil
+	}
+
+	r, size := utf8.DecodeRuneInString(value)
+	if size == 0 || size != len(value) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+		return 0, fmt.Errorf("%w: delimiter %q, it must be one character", errCSVOption, value)
+	}
+	return r, nil
+}
+
+// parseEncoding returns a supported file encoding name.
+func parseEncoding(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case encodingUTF8, "utf8":
+		return encodingUTF8, nil
+	case encodingWindows1251, "cp1251":
+		return encodingWindows1251, nil
+	default:
+		return "", fmt.Errorf("%w: encoding %q, expected utf-8 or windows-1251", errCSVOption, value)
+	}
+}
+
+// sameFile returns true if both paths are existing files and they are the same file.
+func sameFile(input, output string) bool {
+	if input == stdinPath || output == "" {
+		return false
+	}
+
+	inputInfo, err := os.Stat(input)
+	if err != nil {
+		return false
+	}
+
+	outputInfo, err := os.Stat(output)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(inputInfo, outputInfo)
+}
+
+// openInput opens the file or returns stdin for "-".
+func openInput(path string) (io.Reader, func(), error) {
+	if path == stdinPath {
+		return os.Stdin, func() {}, nil
+	}
+
+	f, err := os.Open(path) //nolint:gosec // the path is set by the user
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+// openOutput creates the file or returns stdout for an empty path.
+func openOutput(path string) (io.Writer, func() error, error) {
+	if path == "" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+
+	f, err := os.Create(path) //nolint:gosec // the path is set by the user
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       string
+		opts        csvOptions
+		want        string
+		wantInvalid int
+	}{
+		{
+			name:  "short rows are padded to the header",
+			input: "inn,name,comment\n7707083893,Sber\n7707083893\n",
+			opts:  csvOptions{comma: ',', header: headerAuto, encoding: encodingUTF8, columns: "inn"},
+			want: "inn,name,comment,inn_valid,inn_kind,inn_error\n" +
+				"7707083893,Sber,,true,juridical,\n" +
+				"7707083893,,,true,juridical,\n",
+		},
+		{
+			name:  "short rows are padded without a header",
+			input: "x;7707083893;y\n;7707083892\n",
+			opts:  csvOptions{comma: ';', header: headerNo, encoding: encodingUTF8, columns: "2"},
+			want: "x;7707083893;y;true;juridical;\n" +
+				";7707083892;;false;juridical;invalid INN checksum: invalid juridical inn, expected 3, got 2\n",
+			wantInvalid: 1,
+		},
+		{
+			name:  "several columns with BOM",
+			input: byteOrderMark + "name,INN,Supplier_INN\nSber,7707083893,\n",
+			opts:  csvOptions{comma: ',', header: headerAuto, encoding: encodingUTF8, columns: "inn, supplier_inn"},
+			want: byteOrderMark + "name,INN,Supplier_INN," +
+				"inn_inn_valid,inn_inn_kind,inn_inn_error,supplier_inn_inn_valid,supplier_inn_inn_kind,supplier_inn_inn_error\n" +
+				"Sber,7707083893,,true,juridical,,,,\n",
+		},
+		{
+			name:  "lazy quotes",
+			input: "name\tinn\nООО \"Ромашка\"\t7707083893\n",
+			opts:  csvOptions{comma: '\t', header: headerAuto, encoding: encodingUTF8, lazyQuotes: true, columns: "2"},
+			want: "name\tinn\tinn_valid\tinn_kind\tinn_error\n" +
+				"\"ООО \"\"Ромашка\"\"\"\t7707083893\ttrue\tjuridical\t\n",
+		},
+		{
+			name:  "windows-1251",
+			input: "\xc8\xcd\xcd;\xc8\xec\xff\r\n7707083893;\xd1\xe1\xe5\xf0\r\n",
+			opts:  csvOptions{comma: ';', header: headerAuto, encoding: encodingWindows1251, columns: "инн"},
+			want: "\xc8\xcd\xcd;\xc8\xec\xff;inn_valid;inn_kind;inn_error\n" +
+				"7707083893;\xd1\xe1\xe5\xf0;true;juridical;\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			check := &csvCheck{}
+
+			if err := checkCSV(check, strings.NewReader(tt.input), &buf, &tt.opts); err != nil {
+				t.Fatalf("checkCSV() error = %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("checkCSV() = %q, want %q", got, tt.want)
+			}
+
+			if check.invalid != tt.wantInvalid {
+				t.Errorf("checkCSV() invalid = %d, want %d", check.invalid, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestCheckCSV_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    csvOptions
+		wantErr error
+	}{
+		{
+			name:    "bare quote",
+			input:   "name,inn\nООО \"Ромашка\",7707083893\n",
+			opts:    csvOptions{comma: ',', header: headerAuto, encoding: encodingUTF8, columns: "inn"},
+			wantErr: csv.ErrBareQuote,
+		},
+		{
+			name:    "unknown column",
+			input:   "name,tax_id\n",
+			opts:    csvOptions{comma: ',', header: headerAuto, encoding: encodingUTF8, columns: "inn"},
+			wantErr: errCSVColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkCSV(&csvCheck{}, strings.NewReader(tt.input), io.Discard, &tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		first      []string
+		spec       string
+		mode       string
+		wantHeader bool
+		want       []csvColumn
+		wantErr    error
+	}{
+		{
+			name:       "auto named",
+			first:      []string{"name", " INN "},
+			spec:       "inn",
+			mode:       headerAuto,
+			wantHeader: true,
+			want:       []csvColumn{{name: "inn", index: 1}},
+		},
+		{
+			name:  "auto numbered with digits",
+			first: []string{"Sber", "7707083893"},
+			spec:  "2",
+			mode:  headerAuto,
+			want:  []csvColumn{{name: "column2", index: 1}},
+		},
+		{
+			name:       "auto numbered without digits",
+			first:      []string{"name", "tax id"},
+			spec:       "2",
+			mode:       headerAuto,
+			wantHeader: true,
+			want:       []csvColumn{{name: "tax id", index: 1}},
+		},
+		{
+			name:       "numbered with header",
+			first:      []string{"id1", "id2"},
+			spec:       "1, 2",
+			mode:       headerYes,
+			wantHeader: true,
+			want:       []csvColumn{{name: "id1", index: 0}, {name: "id2", index: 1}},
+		},
+		{
+			name:  "numbered out of the first record",
+			first: []string{"7707083893"},
+			spec:  "3",
+			mode:  headerNo,
+			want:  []csvColumn{{name: "column3", index: 2}},
+		},
+		{
+			name:    "named without header",
+			first:   []string{"7707083893"},
+			spec:    "inn",
+			mode:    headerNo,
+			wantErr: errCSVColumn,
+		},
+		{
+			name:    "unknown name",
+			first:   []string{"name", "tax_id"},
+			spec:    "inn",
+			mode:    headerAuto,
+			wantErr: errCSVColumn,
+		},
+		{
+			name:    "zero number",
+			first:   []string{"7707083893"},
+			spec:    "0",
+			mode:    headerNo,
+			wantErr: errCSVColumn,
+		},
+		{
+			name:    "empty name",
+			first:   []string{"inn"},
+			spec:    "inn,",
+			mode:    headerAuto,
+			wantErr: errCSVColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hasHeader, got, err := resolveColumns(tt.first, tt.spec, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if hasHeader != tt.wantHeader {
+				t.Errorf("resolveColumns() header = %v, want %v", hasHeader, tt.wantHeader)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolveColumns() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.csv")
+	other := filepath.Join(dir, "other.csv")
+	link := filepath.Join(dir, "link.csv")
+
+	for _, path := range []string{input, other} {
+		if err := os.WriteFile(path, []byte("inn\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	if err := os.Symlink(input, link); err != nil {
+		t.Fatalf("Symlink() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		output string
+		want   bool
+	}{
+		{name: "same path", input: input, output: input, want: true},
+		{name: "relative path", input: input, output: filepath.Join(dir, ".", "input.csv"), want: true},
+		{name: "symlink", input: input, output: link, want: true},
+		{name: "other file", input: input, output: other},
+		{name: "new file", input: input, output: filepath.Join(dir, "new.csv")},
+		{name: "stdout", input: input, output: ""},
+		{name: "stdin", input: stdinPath, output: input},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sameFile(tt.input, tt.output); got != tt.want {
+				t.Errorf("sameFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/z0rr0/inngen/inn"
+	"github.com/z0rr0/inngen/web"
+)
+
+const (
+	// defaultCount is the default number of generated values.
+	defaultCount = 5
+
+	generateOrganization = "organization"
+	generatePerson       = "person"
+)
+
+// newFlagSet returns a flag set of the subcommand with its usage text.
+func newFlagSet(cmd, arguments, description string) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.Usage = func() {
+		out := fs.Output()
+		usage := strings.TrimSpace(fmt.Sprintf("%s %s [flags] %s", binName, cmd, arguments))
+		_, _ = fmt.Fprintf(out, "Usage: %s\n\n%s\n", usage, description)
+
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			_, _ = fmt.Fprintln(out, "\nFlags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseFlags parses the subcommand arguments, the flag set prints errors and usage itself.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err == nil || err == flag.ErrHelp { //nolint:errorlint // flag.Parse returns the sentinel as is
+		return err
+	}
+	return errUsage
+}
+
+// usageError prints the error message and the subcommand usage.
+func usageError(fs *flag.FlagSet, format string, args ...any) error {
+	_, _ = fmt.Fprintf(fs.Output(), "Error: "+format+"\n\n", args...)
+	fs.Usage()
+	return errUsage
+}
+
+// isFlagSet returns true if the subcommand flag was set explicitly.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	found := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			found = true
+		}
+	})
+	return found
+}
+
+// runGenerate generates INNs, organizations or physical persons.
+func runGenerate(args []string) error {
+	fs := newFlagSet("generate", "", "Generates valid INNs, consistent organizations or physical persons.")
+	kind := fs.String("kind", string(inn.KindPhysical), "what to generate: physical, juridical, foreign, organization or person")
+	count := fs.Int("n", defaultCount, "number of generated values")
+	seed := fs.Uint64("seed", 0, "seed for reproducible generation, random if not set")
+	region := fs.Int("region", 0, "region code (1-99) of generated INNs, random if not set")
+	office := fs.Int("office", 0, "tax office code (1-99) of generated INNs, requires -region")
+	entrepreneur := fs.Bool("entrepreneur", false, "add OGRNIP of individual entrepreneurs, used with -kind person")
+	output := fs.String("o", string(formatPlain), "output format: plain, json, ndjson or csv")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	format, err := parseOutputFormat(*output)
+	if err != nil {
+		return usageError(fs, "%v", err)
+	}
+
+	switch {
+	case fs.NArg() > 0:
+		return usageError(fs, "unexpected arguments %q", fs.Args())
+	case *count < 1:
+		return usageError(fs, "number of generated values must be positive, got %d", *count)
+	case *entrepreneur && *kind != generatePerson:
+		return usageError(fs, "flag -entrepreneur can be used only with -kind %s", generatePerson)
+	}
+
+	var options []inn.Option
+	if isFlagSet(fs, "seed") {
+		options = append(options, inn.WithSeed(*seed))
+	}
+	if isFlagSet(fs, "region") {
+		options = append(options, inn.WithRegion(*region))
+	}
+	if isFlagSet(fs, "office") {
+		options = append(options, inn.WithTaxOffice(*office))
+	}
+
+	innKind := inn.KindUnknown
+	if *kind != generateOrganization && *kind != generatePerson {
+		if innKind, err = inn.ParseKind(*kind); err != nil {
+			return usageError(fs, "%v", err)
+		}
+		options = append(options, inn.WithKind(innKind))
+	}
+
+	generator, err := inn.NewGenerator(options...)
+	if err != nil {
+		if errors.Is(err, inn.ErrGeneratorOption) {
+			return usageError(fs, "%v", err)
+		}
+		return fmt.Errorf("creating generator: %w", err)
+	}
+
+	switch *kind {
+	case generateOrganization:
+		return generateOrganizations(generator, *count, format)
+	case generatePerson:
+		return generatePersons(generator, *count, *entrepreneur, format)
+	default:
+		return generateINNs(generator, innKind, *count, format)
+	}
+}
+
+// generateINNs prints generated INNs of the generator's kind, one per line in the plain format.
+func generateINNs(generator *inn.Generator, kind inn.Kind, count int, format outputFormat) error {
+	enc := newEncoder(os.Stdout, format, resultHeader)
+	for range count {
+		value, err := generator.Generate()
+		if err != nil {
+			return err
+		}
+
+		if format == formatPlain {
+			fmt.Println(value)
+			continue
+		}
+
+		r := &result{INN: value, Kind: kind, Valid: true}
+		if err = enc.encode(r, r.row(false)); err != nil {
+			return err
+		}
+	}
+	return enc.close()
+}
+
+// generateOrganizations prints generated organizations.
+func generateOrganizations(generator *inn.Generator, count int, format outputFormat) error {
+	enc := newEncoder(os.Stdout, format, organizationHeader)
+	for i := range count {
+		org, err := generator.Organization()
+		if err != nil {
+			return err
+		}
+
+		if format == formatPlain {
+			printOrganization(i+1, org)
+			continue
+		}
+
+		if err = enc.encode(org, organizationRow(org)); err != nil {
+			return err
+		}
+	}
+	return enc.close()
+}
+
+// generatePersons prints generated physical persons.
+func generatePersons(generator *inn.Generator, count int, entrepreneur bool, format outputFormat) error {
+	enc := newEncoder(os.Stdout, format, personHeader)
+	for i := range count {
+		person, err := generator.Person(entrepreneur)
+		if err != nil {
+			return err
+		}
+
+		if format == formatPlain {
+			printPerson(i+1, person)
+			continue
+		}
+
+		if err = enc.encode(person, personRow(person)); err != nil {
+			return err
+		}
+	}
+	return enc.close()
+}
+
+// runInfo prints structured information about INN.
+func runInfo(args []string) error {
+	fs := newFlagSet("info", "<INN>", "Shows the kind, region, tax office and serial number of a valid INN.")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return usageError(fs, "expected one INN, got %d arguments", fs.NArg())
+	}
+
+	value := fs.Arg(0)
+	info, err := inn.Parse(value)
+	if err != nil {
+		fmt.Println(inn.FmtResult(value, err))
+		return errInvalid
+	}
+
+	printInfo(info)
+	return nil
+}
+
+// runSQL prints SQL check function and constraint for the dialect.
+func runSQL(args []string) error {
+	fs := newFlagSet("sql", "<postgres|sqlite>", "Prints SQL check function and constraint template for the dialect.")
+	column := fs.String("column", inn.DefaultColumn, "column name in the SQL constraint template")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return usageError(fs, "expected one SQL dialect, got %d arguments", fs.NArg())
+	}
+
+	return printSQL(fs.Arg(0), *column)
+}
+
+// runServe runs the web application until it is stopped by a signal.
+func runServe(args []string) error {
+	fs := newFlagSet("serve", "", "Runs the web application with HTML pages and JSON REST API.")
+	addr := fs.String("addr", web.DefaultAddr, "web server address host:port")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if fs.NArg() > 0 {
+		return usageError(fs, "unexpected arguments %q", fs.Args())
+	}
+
+	return runServer(*addr)
+}
+
+// runVersion prints the version information.
+func runVersion(args []string) error {
+	fs := newFlagSet("version", "", "Shows version, build and registry information.")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	fmt.Println(name + ": INN (Taxpayer Identification Number) Generator and Validator")
+	fmt.Printf(
+		"Version: %v\nRevision: %v\nBuild date: %v\nGo version: %v\nRegistry version: %v\n",
+		Version, Revision, BuildDate, GoVersion, inn.RegistryVersion(),
+	)
+	return nil
+}
+
+// runServer starts the web server and blocks until it is stopped by a signal.
+func runServer(addr string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server, err := web.NewServer(addr, slog.Default())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Starting web server on %s...\n", addr)
+	return server.Run(ctx)
+}
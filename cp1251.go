@@ -0,0 +1,137 @@
+package main
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+const (
+	// asciiMax is the last byte which is the same in ASCII, UTF-8 and Windows-1251.
+	asciiMax = 0x7F
+	// unmappedByte replaces characters which can not be encoded in Windows-1251.
+	unmappedByte = '?'
+	// codecBufferSize is the size of the source buffer of the Windows-1251 decoder.
+	codecBufferSize = 4096
+)
+
+// windows1251 maps bytes 0x80-0xFF of Windows-1251 to runes, byte 0x98 is not assigned.
+var windows1251 = [128]rune{ //nolint:gochecknoglobals
+	0x0402, 0x0403, 0x201A, 0x0453, 0x201E, 0x2026, 0x2020, 0x2021, // 0x80-0x87
+	0x20AC, 0x2030, 0x0409, 0x2039, 0x040A, 0x040C, 0x040B, 0x040F, // 0x88-0x8F
+	0x0452, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014, // 0x90-0x97
+	0xFFFD, 0x2122, 0x0459, 0x203A, 0x045A, 0x045C, 0x045B, 0x045F, // 0x98-0x9F
+	0x00A0, 0x040E, 0x045E, 0x0408, 0x00A4, 0x0490, 0x00A6, 0x00A7, // 0xA0-0xA7
+	0x0401, 0x00A9, 0x0404, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x0407, // 0xA8-0xAF
+	0x00B0, 0x00B1, 0x0406, 0x0456, 0x0491, 0x00B5, 0x00B6, 0x00B7, // 0xB0-0xB7
+	0x0451, 0x2116, 0x0454, 0x00BB, 0x0458, 0x0405, 0x0455, 0x0457, // 0xB8-0xBF
+	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417, // 0xC0-0xC7
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F, // 0xC8-0xCF
+	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427, // 0xD0-0xD7
+	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F, // 0xD8-0xDF
+	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437, // 0xE0-0xE7
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F, // 0xE8-0xEF
+	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447, // 0xF0-0xF7
+	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F, // 0xF8-0xFF
+}
+
+// windows1251Bytes maps runes to Windows-1251 bytes 0x80-0xFF.
+var windows1251Bytes = func() map[rune]byte { //nolint:gochecknoglobals
+	m := make(map[rune]byte, len(windows1251))
+	for i, r := range windows1251 {
+		if r != utf8.RuneError {
+			m[r] = byte(asciiMax + 1 + i)
+		}
+	}
+	return m
+}()
+
+// windows1251Reader decodes Windows-1251 text from the source reader to UTF-8.
+type windows1251Reader struct {
+	src     io.Reader
+	buf     []byte
+	pending []byte
+	err     error
+}
+
+// newWindows1251Reader returns a reader which decodes Windows-1251 text to UTF-8.
+func newWindows1251Reader(src io.Reader) io.Reader {
+	return &windows1251Reader{src: src, buf: make([]byte, codecBufferSize)}
+}
+
+// Read reads UTF-8 decoded text.
+func (r *windows1251Reader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		n, err := r.src.Read(r.buf)
+		r.pending, r.err = r.pending[:0], err
+
+		for _, b := range r.buf[:n] {
+			if b <= asciiMax {
+				r.pending = append(r.pending, b)
+				continue
+			}
+			r.pending = utf8.AppendRune(r.pending, windows1251[b-asciiMax-1])
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// windows1251Writer encodes UTF-8 text to Windows-1251 and writes it to the destination writer,
+// characters which are absent in Windows-1251 are replaced by "?".
+type windows1251Writer struct {
+	dst     io.Writer
+	partial []byte
+}
+
+// newWindows1251Writer returns a writer which encodes UTF-8 text to Windows-1251,
+// Flush must be called after the last Write.
+func newWindows1251Writer(dst io.Writer) *windows1251Writer {
+	return &windows1251Writer{dst: dst}
+}
+
+// Write encodes and writes UTF-8 text, an incomplete rune at the end is kept for the next call.
+func (w *windows1251Writer) Write(p []byte) (int, error) {
+	data := append(w.partial, p...) //nolint:gocritic // partial is a short tail of the previous call
+	encoded := make([]byte, 0, len(data))
+
+	for len(data) > 0 {
+		if !utf8.FullRune(data) {
+			break
+		}
+
+		r, size := utf8.DecodeRune(data)
+		data = data[size:]
+
+		switch b, ok := windows1251Bytes[r]; {
+		case r <= asciiMax:
+			encoded = append(encoded, byte(r))
+		case ok:
+			encoded = append(encoded, b)
+		default:
+			encoded = append(encoded, unmappedByte)
+		}
+	}
+
+	w.partial = append(w.partial[:0:0], data...)
+	if _, err := w.dst.Write(encoded); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes an incomplete rune kept from the last Write as "?", it is an invalid UTF-8 tail.
+func (w *windows1251Writer) Flush() error {
+	if len(w.partial) == 0 {
+		return nil
+	}
+
+	w.partial = w.partial[:0]
+	_, err := w.dst.Write([]byte{unmappedByte})
+	return err
+}
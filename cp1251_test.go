@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWindows1251Reader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{name: "ascii", input: []byte("inn;7707083893"), want: "inn;7707083893"},
+		{name: "cyrillic", input: []byte{0xCF, 0xF0, 0xE8, 0xE2, 0xE5, 0xF2, 0x20, 0xA8, 0xB8}, want: "Привет Ёё"},
+		{name: "symbols", input: []byte{0xB9, 0x88, 0xAB, 0xBB}, want: "№€«»"},
+		{name: "unassigned byte", input: []byte{0x41, 0x98, 0x42}, want: "A\uFFFDB"},
+		{name: "empty", input: []byte{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := io.ReadAll(newWindows1251Reader(bytes.NewReader(tt.input)))
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("windows1251Reader = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindows1251Writer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		chunks []string
+		want   []byte
+	}{
+		{name: "cyrillic", chunks: []string{"Привет Ёё"}, want: []byte{0xCF, 0xF0, 0xE8, 0xE2, 0xE5, 0xF2, 0x20, 0xA8, 0xB8}},
+		{name: "unmapped rune", chunks: []string{"a€b✓"}, want: []byte{'a', 0x88, 'b', '?'}},
+		{name: "rune split across writes", chunks: []string{"Я"[:1], "Я"[1:] + "я"}, want: []byte{0xDF, 0xFF}},
+		{name: "rune split in three writes", chunks: []string{"✓"[:1], "✓"[1:2], "✓"[2:]}, want: []byte{'?'}},
+		{name: "incomplete rune at the end", chunks: []string{"a", "Я"[:1]}, want: []byte{'a', '?'}},
+		{name: "invalid byte", chunks: []string{"a\xffb"}, want: []byte{'a', '?', 'b'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w := newWindows1251Writer(&buf)
+
+			for _, chunk := range tt.chunks {
+				n, err := w.Write([]byte(chunk))
+				if err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+				if n != len(chunk) {
+					t.Errorf("Write() = %d, want %d", n, len(chunk))
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			if got := buf.Bytes(); !bytes.Equal(got, tt.want) {
+				t.Errorf("windows1251Writer = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindows1251RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var all strings.Builder
+	for _, r := range windows1251 {
+		if r != utf8.RuneError {
+			all.WriteRune(r)
+		}
+	}
+	want := "ascii 0-9 " + all.String()
+
+	var encoded bytes.Buffer
+	w := newWindows1251Writer(&encoded)
+	if _, err := w.Write([]byte(want)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	got, err := io.ReadAll(newWindows1251Reader(&encoded))
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	if string(got) != want {
+		t.Errorf("round trip = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,193 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const (
+	addressesFile = "data/addresses.tsv"
+
+	// indexPrefixLength is the length of a postal index prefix in the address dataset.
+	indexPrefixLength = 3
+	// maxBuilding is the maximum generated building number.
+	maxBuilding = 150
+	// maxBlock is the maximum generated building block number.
+	maxBlock = 5
+	// blockRarity is the inverse ratio of buildings with blocks.
+	blockRarity = 4
+	// maxIndexSuffix is the upper bound of the last 3 postal index digits.
+	maxIndexSuffix = 1000
+	// cityPrefix is a prefix of city names in addresses.
+	cityPrefix = "г. "
+)
+
+var (
+	// loadAddresses loads the embedded address dataset only once.
+	loadAddresses = sync.OnceValues(newAddressBook) //nolint:gochecknoglobals
+
+	// streets are street names of generated addresses.
+	streets = []string{ //nolint:gochecknoglobals
+		"ул. Ленина", "ул. Советская", "ул. Мира", "ул. Молодёжная", "ул. Центральная", "ул. Школьная",
+		"ул. Садовая", "ул. Лесная", "ул. Набережная", "ул. Гагарина", "ул. Пушкина", "ул. Кирова",
+		"ул. Строителей", "ул. Победы", "ул. Заводская", "ул. Комсомольская", "ул. Октябрьская", "ул. Чехова",
+		"пр-т Мира", "пр-т Ленина", "пр-т Победы", "пер. Почтовый", "пер. Садовый", "б-р Строителей",
+	}
+
+	// ErrAddressGeneration is an error indicating an error during address generation.
+	ErrAddressGeneration = errors.New("failed to generate address")
+)
+
+// Address is a fake Russian postal address.
+type Address struct {
+	Index      string `json:"index"`
+	RegionCode string `json:"region_code"`
+	Region     string `json:"region"`
+	City       string `json:"city"`
+	Street     string `json:"street"`
+	Building   string `json:"building"`
+}
+
+// String returns the address in the postal order, the city is omitted for federal cities like Moscow.
+func (a *Address) String() string {
+	parts := []string{a.Index, a.Region}
+	if city := cityPrefix + a.City; city != a.Region {
+		parts = append(parts, city)
+	}
+	return strings.Join(append(parts, a.Street, a.Building), ", ")
+}
+
+// addressCity is a city with its postal index prefix.
+type addressCity struct {
+	index string
+	name  string
+}
+
+// addressBook is a dataset of cities by region codes.
+type addressBook struct {
+	version string
+	cities  map[string][]addressCity
+	regions []string
+}
+
+// newAddressBook reads the embedded address dataset.
+func newAddressBook() (*addressBook, error) {
+	book := &addressBook{cities: make(map[string][]addressCity)}
+
+	directives, err := readDataFile(addressesFile, func(n int, line string) error {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 || len(fields[0]) != regionLength || !isDigits(fields[0]) ||
+			len(fields[1]) != indexPrefixLength || !isDigits(fields[1]) || fields[2] == "" {
+			return fmt.Errorf("invalid line %d in %s: %q", n, addressesFile, line)
+		}
+
+		region := fields[0]
+		if _, ok := book.cities[region]; !ok {
+			book.regions = append(book.regions, region)
+		}
+
+		book.cities[region] = append(book.cities[region], addressCity{index: fields[1], name: fields[2]})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	book.version = directives[versionDirective]
+	slices.Sort(book.regions)
+
+	return book, nil
+}
+
+// Address generates a fake postal address in the generator's region or in a random one.
+func (g *Generator) Address() (*Address, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	region := g.region
+	if region == 0 {
+		book, err := loadAddresses()
+		if err != nil {
+			return nil, errors.Join(ErrAddressGeneration, err)
+		}
+
+		if region, err = g.randomCode(book.regions); err != nil {
+			return nil, errors.Join(ErrAddressGeneration, err)
+		}
+	}
+
+	return g.address(fmt.Sprintf("%02d", region))
+}
+
+// AddressForINN generates a fake postal address in the region of the INN.
+func (g *Generator) AddressForINN(inn string) (*Address, error) {
+	validator := NewValidator(inn, 0)
+	if err := validator.Validate(); err != nil {
+		return nil, errors.Join(ErrAddressGeneration, err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.address(validator.inn[:regionLength])
+}
+
+// address generates a fake postal address in the region. The caller must hold the generator lock.
+func (g *Generator) address(region string) (*Address, error) {
+	book, err := loadAddresses()
+	if err != nil {
+		return nil, errors.Join(ErrAddressGeneration, err)
+	}
+
+	cities := book.cities[region]
+	if len(cities) == 0 {
+		return nil, fmt.Errorf("%w: no cities in region %s", ErrAddressGeneration, region)
+	}
+
+	var indexes [5]int
+	for i, n := range []int{len(cities), len(streets), maxIndexSuffix, maxBuilding, maxBlock * blockRarity} {
+		if indexes[i], err = randomInt(g.random(), n); err != nil {
+			return nil, errors.Join(ErrAddressGeneration, err)
+		}
+	}
+
+	city := cities[indexes[0]]
+	address := &Address{
+		Index:      fmt.Sprintf("%s%03d", city.index, indexes[2]),
+		RegionCode: region,
+		City:       city.name,
+		Street:     streets[indexes[1]],
+		Building:   fmt.Sprintf("д. %d", indexes[3]+1),
+	}
+
+	if block := indexes[4]; block < maxBlock {
+		address.Building += fmt.Sprintf(", корп. %d", block+1)
+	}
+
+	address.Region, _ = RegionName(region)
+	return address, nil
+}
+
+// regionAddress generates a fake postal address in the region or returns nil
+// if the dataset has no cities in the region. The caller must hold the generator lock.
+func (g *Generator) regionAddress(region int) (*Address, error) {
+	book, err := loadAddresses()
+	if err != nil {
+		return nil, errors.Join(ErrAddressGeneration, err)
+	}
+
+	code := fmt.Sprintf("%02d", region)
+	if len(book.cities[code]) == 0 {
+		return nil, nil //nolint:nilnil // the address is optional
+	}
+
+	return g.address(code)
+}
+
+// GenerateAddress generates a fake postal address in the region of the INN.
+func GenerateAddress(inn string) (*Address, error) {
+	return defaultGenerator.AddressForINN(inn)
+}
@@ -0,0 +1,113 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewAddressBook(t *testing.T) {
+	t.Parallel()
+
+	book, err := newAddressBook()
+	if err != nil {
+		t.Fatalf("newAddressBook() error = %v", err)
+	}
+
+	if v := RegistryVersion(); book.version != v {
+		t.Errorf("address dataset version = %q, want registry version %q", book.version, v)
+	}
+
+	r, err := loadRegistry()
+	if err != nil {
+		t.Fatalf("loadRegistry() error = %v", err)
+	}
+
+	// every registry region except interregional inspections should have addresses
+	for _, region := range r.regionCodes {
+		if len(book.cities[region]) == 0 {
+			t.Errorf("no cities in region %s", region)
+		}
+	}
+
+	for _, region := range book.regions {
+		if _, ok := r.regions[region]; !ok {
+			t.Errorf("unknown region %s in the address dataset", region)
+		}
+	}
+}
+
+func TestGenerateAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inn     string
+		want    string
+		wantErr error
+	}{
+		{name: "Moscow", inn: "7707083893", want: "г. Москва"},
+		{name: "Moscow region", inn: "500100732259", want: "Московская область"},
+		{name: "foreign organization", inn: "9909123454", wantErr: ErrAddressGeneration},
+		{name: "invalid INN", inn: "7707083892", wantErr: ErrInnChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateAddress(tt.inn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GenerateAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Region != tt.want || got.RegionCode != tt.inn[:regionLength] {
+				t.Errorf("GenerateAddress() = %+v, want region %q", got, tt.want)
+			}
+
+			if len(got.Index) != 6 || !isDigits(got.Index) || got.City == "" || got.Street == "" {
+				t.Errorf("GenerateAddress() = %+v, incomplete address", got)
+			}
+		})
+	}
+}
+
+func TestGenerator_Address(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(2), WithRegion(77))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	a, err := g.Address()
+	if err != nil {
+		t.Fatalf("Address() error = %v", err)
+	}
+
+	if s := a.String(); !strings.HasPrefix(s, a.Index+", г. Москва, "+a.Street+", д. ") {
+		t.Errorf("String() = %q, want Moscow address without a duplicated city", s)
+	}
+
+	if _, err = g.Address(); err != nil {
+		t.Errorf("Address() error = %v", err)
+	}
+
+	g, err = NewGenerator(WithRegion(98))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if _, err = g.Address(); !errors.Is(err, ErrAddressGeneration) {
+		t.Errorf("Address() error = %v, want %v", err, ErrAddressGeneration)
+	}
+
+	org, err := g.Organization()
+	if err != nil || org.Address != nil {
+		t.Errorf("Organization() = %+v, %v, want no address", org, err)
+	}
+}
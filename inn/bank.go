@@ -0,0 +1,238 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// BIKLength is the valid length for a bank identification code (BIK).
+	BIKLength = 9
+	// AccountLength is the valid length for settlement and correspondent bank accounts.
+	AccountLength = 20
+
+	// bikPrefix is a country code of Russian BIKs.
+	bikPrefix = "04"
+	// accountKeyPosition is a 1-based position of the account control key.
+	accountKeyPosition = 9
+	// settlementPrefix is a prefix of generated settlement accounts: legal entity account in rubles.
+	settlementPrefix = "40702810"
+	// corrPrefix is a prefix of generated correspondent accounts of credit organizations in rubles.
+	corrPrefix = "30101810"
+	// minBankNumber is the minimal credit organization number in BIK, lower ones are Bank of Russia divisions.
+	minBankNumber = 50
+	// maxBankNumber is the maximum credit organization number in BIK.
+	maxBankNumber = 999
+)
+
+var (
+	// weightsAccount are weights of the bank account control key digits, 3 BIK-based digits and 20 account digits.
+	weightsAccount = []int{7, 1, 3, 7, 1, 3, 7, 1, 3, 7, 1, 3, 7, 1, 3, 7, 1, 3, 7, 1, 3, 7, 1} //nolint:gochecknoglobals
+
+	// ErrBikLength is an error indicating an invalid BIK length.
+	ErrBikLength = errors.New("invalid BIK length")
+	// ErrBikFormat is an error indicating a non-digit character or an invalid country code of BIK.
+	ErrBikFormat = errors.New("invalid BIK format")
+	// ErrAccountLength is an error indicating an invalid bank account length.
+	ErrAccountLength = errors.New("invalid bank account length")
+	// ErrAccountFormat is an error indicating a non-digit character in a bank account.
+	ErrAccountFormat = errors.New("invalid bank account format")
+	// ErrAccountChecksum is an error indicating an invalid bank account control key.
+	ErrAccountChecksum = errors.New("invalid bank account checksum")
+	// ErrBankGeneration is an error indicating an error during BIK or bank account generation.
+	ErrBankGeneration = errors.New("failed to generate bank details")
+)
+
+// BankDetails are consistent bank requisites: BIK, the bank correspondent account and a customer settlement account.
+type BankDetails struct {
+	BIK         string `json:"bik"`
+	CorrAccount string `json:"corr_account"`
+	Account     string `json:"account"`
+}
+
+// ValidateBIK checks the correctness of a 9-digit Russian BIK, it should start with the country code 04.
+func ValidateBIK(bik string) error {
+	_, err := parseBIK(bik)
+	return err
+}
+
+// ValidateAccount checks the control key of a 20-digit settlement account in the bank with the BIK,
+// the key is calculated for the last 3 BIK digits followed by the account digits.
+func ValidateAccount(account, bik string) error {
+	digits, err := parseBIK(bik)
+	if err != nil {
+		return err
+	}
+	return validateAccount(account, digits[6:])
+}
+
+// ValidateCorrAccount checks the control key of a 20-digit correspondent account of the bank with the BIK,
+// the key is calculated for "0" and the 5th and 6th BIK digits followed by the account digits.
+func ValidateCorrAccount(account, bik string) error {
+	digits, err := parseBIK(bik)
+	if err != nil {
+		return err
+	}
+	return validateAccount(account, corrKeyPrefix(digits))
+}
+
+// parseBIK validates BIK and returns its digits.
+func parseBIK(bik string) ([]int, error) {
+	value := strings.TrimSpace(bik)
+
+	if n := len(value); n != BIKLength {
+		return nil, newLengthError(ErrBikLength, strconv.Itoa(BIKLength), n, fmt.Sprintf("got %d, expected %d", n, BIKLength))
+	}
+
+	digits, err := parseDigits(value, ErrBikFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix := value[:len(bikPrefix)]; prefix != bikPrefix {
+		return nil, &ValidationError{
+			Err:      ErrBikFormat,
+			Kind:     ErrorKindFormat,
+			Position: 1,
+			Expected: bikPrefix,
+			Actual:   prefix,
+			Message:  fmt.Sprintf("invalid country code %s, expected %s", prefix, bikPrefix),
+		}
+	}
+
+	return digits, nil
+}
+
+// corrKeyPrefix returns "0" and the 5th and 6th BIK digits, they are used for correspondent account keys.
+func corrKeyPrefix(bik []int) []int {
+	return []int{0, bik[4], bik[5]}
+}
+
+// validateAccount checks the length, format and control key of the account with the 3-digit key prefix.
+func validateAccount(account string, prefix []int) error {
+	value := strings.TrimSpace(account)
+
+	if n := len(value); n != AccountLength {
+		return newLengthError(
+			ErrAccountLength, strconv.Itoa(AccountLength), n, fmt.Sprintf("got %d, expected %d", n, AccountLength),
+		)
+	}
+
+	digits, err := parseDigits(value, ErrAccountFormat)
+	if err != nil {
+		return err
+	}
+
+	key := accountKey(prefix, digits)
+	if actual := digits[accountKeyPosition-1]; key != actual {
+		return newChecksumError(
+			ErrAccountChecksum, accountKeyPosition, key, actual,
+			fmt.Sprintf("control key is %d, expected %d", actual, key),
+		)
+	}
+
+	return nil
+}
+
+// accountKey calculates the account control key: the sum of the last digits of weighted
+// prefix and account digits should be a multiple of 10, the key digit itself is ignored.
+func accountKey(prefix, account []int) int {
+	const keyIndex = accountKeyPosition - 1
+
+	sum := 0
+	for i, d := range prefix {
+		sum += d * weightsAccount[i] % 10
+	}
+
+	for i, d := range account {
+		if i != keyIndex {
+			sum += d * weightsAccount[len(prefix)+i] % 10
+		}
+	}
+
+	// the key weight is 3, so the key is 3 * sum mod 10 to make the total sum a multiple of 10
+	return sum % 10 * 3 % 10
+}
+
+// BIK generates a valid Russian BIK of a credit organization.
+func (g *Generator) BIK() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// country (2) + region (2) + division (2) + credit organization number (3)
+	digits, err := randomDigits(g.random(), BIKLength-len(bikPrefix)-3)
+	if err != nil {
+		return "", errors.Join(ErrBankGeneration, err)
+	}
+
+	n, err := randomInt(g.random(), maxBankNumber-minBankNumber+1)
+	if err != nil {
+		return "", errors.Join(ErrBankGeneration, err)
+	}
+
+	return fmt.Sprintf("%s%s%03d", bikPrefix, digitsToString(digits), minBankNumber+n), nil
+}
+
+// Account generates a valid settlement account of a legal entity in rubles in the bank with the BIK.
+func (g *Generator) Account(bik string) (string, error) {
+	digits, err := parseBIK(bik)
+	if err != nil {
+		return "", errors.Join(ErrBankGeneration, err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	random, err := randomDigits(g.random(), AccountLength-len(settlementPrefix)-1)
+	if err != nil {
+		return "", errors.Join(ErrBankGeneration, err)
+	}
+
+	return withAccountKey(settlementPrefix+"0"+digitsToString(random), digits[6:]), nil
+}
+
+// CorrAccount returns a valid correspondent account in rubles of the bank with the BIK,
+// it ends with the last 3 BIK digits.
+func (g *Generator) CorrAccount(bik string) (string, error) {
+	digits, err := parseBIK(bik)
+	if err != nil {
+		return "", errors.Join(ErrBankGeneration, err)
+	}
+
+	account := corrPrefix + "0" + strings.Repeat("0", AccountLength-len(corrPrefix)-4) + strings.TrimSpace(bik)[6:]
+	return withAccountKey(account, corrKeyPrefix(digits)), nil
+}
+
+// BankDetails generates consistent BIK, correspondent and settlement accounts.
+func (g *Generator) BankDetails() (*BankDetails, error) {
+	bik, err := g.BIK()
+	if err != nil {
+		return nil, err
+	}
+
+	corrAccount, err := g.CorrAccount(bik)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := g.Account(bik)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BankDetails{BIK: bik, CorrAccount: corrAccount, Account: account}, nil
+}
+
+// withAccountKey replaces the control key of the valid digits-only account.
+func withAccountKey(account string, prefix []int) string {
+	digits, _ := parseDigits(account, ErrAccountFormat)
+	key := accountKey(prefix, digits)
+	return account[:accountKeyPosition-1] + strconv.Itoa(key) + account[accountKeyPosition:]
+}
+
+// GenerateBankDetails generates consistent BIK, correspondent and settlement accounts.
+func GenerateBankDetails() (*BankDetails, error) {
+	return defaultGenerator.BankDetails()
+}
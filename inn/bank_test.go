@@ -0,0 +1,145 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateBIK(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		bik     string
+		wantErr error
+		wantPos int
+	}{
+		{name: "valid", bik: "044525225"},
+		{name: "with spaces", bik: " 044525593 "},
+		{name: "short", bik: "04452522", wantErr: ErrBikLength},
+		{name: "letter", bik: "0445252X5", wantErr: ErrBikFormat, wantPos: 8},
+		{name: "country code", bik: "144525225", wantErr: ErrBikFormat, wantPos: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateBIK(tt.bik)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateBIK() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if ve, ok := AsValidationError(err); ok && ve.Position != tt.wantPos {
+				t.Errorf("ValidationError.Position = %d, want %d", ve.Position, tt.wantPos)
+			}
+		})
+	}
+}
+
+func TestValidateAccount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		account string
+		bik     string
+		corr    bool
+		wantErr error
+	}{
+		{name: "settlement", account: "40702810638000000000", bik: "044525225"},
+		{name: "personal", account: "40817810499910004312", bik: "044525593"},
+		{name: "correspondent", account: "30101810400000000225", bik: "044525225", corr: true},
+		{name: "correspondent with spaces", account: " 30101810200000000593 ", bik: "044525593", corr: true},
+		{name: "settlement key", account: "40702810538000000000", bik: "044525225", wantErr: ErrAccountChecksum},
+		{name: "another bank", account: "40702810638000000000", bik: "044525593", wantErr: ErrAccountChecksum},
+		{name: "correspondent as settlement", account: "30101810400000000225", bik: "044525225", wantErr: ErrAccountChecksum},
+		{name: "short", account: "4070281063800000000", bik: "044525225", wantErr: ErrAccountLength},
+		{name: "letter", account: "4070281063800000000X", bik: "044525225", wantErr: ErrAccountFormat},
+		{name: "invalid BIK", account: "40702810638000000000", bik: "144525225", wantErr: ErrBikFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validate := ValidateAccount
+			if tt.corr {
+				validate = ValidateCorrAccount
+			}
+
+			err := validate(tt.account, tt.bik)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !errors.Is(err, ErrAccountChecksum) {
+				return
+			}
+
+			ve, ok := AsValidationError(err)
+			if !ok || ve.Kind != ErrorKindChecksum || ve.Position != accountKeyPosition {
+				t.Errorf("ValidationError = %+v, want checksum at position %d", ve, accountKeyPosition)
+			}
+		})
+	}
+}
+
+func TestGenerateBankDetails(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		details, err := GenerateBankDetails()
+		if err != nil {
+			t.Fatalf("GenerateBankDetails() error = %v", err)
+		}
+
+		if err = ValidateBIK(details.BIK); err != nil {
+			t.Errorf("ValidateBIK(%s) error = %v", details.BIK, err)
+		}
+
+		if err = ValidateCorrAccount(details.CorrAccount, details.BIK); err != nil {
+			t.Errorf("ValidateCorrAccount(%s, %s) error = %v", details.CorrAccount, details.BIK, err)
+		}
+
+		if !strings.HasSuffix(details.CorrAccount, details.BIK[6:]) {
+			t.Errorf("CorrAccount = %s, want suffix %s", details.CorrAccount, details.BIK[6:])
+		}
+
+		if err = ValidateAccount(details.Account, details.BIK); err != nil {
+			t.Errorf("ValidateAccount(%s, %s) error = %v", details.Account, details.BIK, err)
+		}
+
+		if !strings.HasPrefix(details.Account, settlementPrefix) {
+			t.Errorf("Account = %s, want prefix %s", details.Account, settlementPrefix)
+		}
+	}
+}
+
+func TestGenerator_Account(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(3))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	account, err := g.Account("044525225")
+	if err != nil {
+		t.Fatalf("Account() error = %v", err)
+	}
+
+	if err = ValidateAccount(account, "044525225"); err != nil {
+		t.Errorf("ValidateAccount(%s) error = %v", account, err)
+	}
+
+	corrAccount, err := g.CorrAccount("044525225")
+	if err != nil || corrAccount != "30101810400000000225" {
+		t.Errorf("CorrAccount() = %s, %v, want 30101810400000000225", corrAccount, err)
+	}
+
+	if _, err = g.Account("04452522"); !errors.Is(err, ErrBankGeneration) || !errors.Is(err, ErrBikLength) {
+		t.Errorf("Account() error = %v, want %v", err, ErrBikLength)
+	}
+}
@@ -0,0 +1,145 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// interregionalPrefix is a region code of interregional tax inspections, for example for the largest taxpayers.
+	interregionalPrefix = "99"
+
+	// ogrnRegionStart is a 0-based position of the region code in OGRN and OGRNIP.
+	ogrnRegionStart = 3
+)
+
+// ErrInconsistentRequisites is an error indicating individually valid but mismatched requisites.
+var ErrInconsistentRequisites = errors.New("inconsistent requisites")
+
+// Mismatch is an inconsistency of the requisites field with the INN.
+type Mismatch struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ConsistencyError is an error with all found requisites mismatches.
+type ConsistencyError struct {
+	Mismatches []Mismatch
+}
+
+// Error returns a string representation of the consistency error.
+func (e *ConsistencyError) Error() string {
+	messages := make([]string, len(e.Mismatches))
+	for i, m := range e.Mismatches {
+		messages[i] = m.Field + ": " + m.Message
+	}
+	return ErrInconsistentRequisites.Error() + ": " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns ErrInconsistentRequisites, so errors.Is can be used with it.
+func (e *ConsistencyError) Unwrap() error {
+	return ErrInconsistentRequisites
+}
+
+// CheckOrganization validates the organization INN, KPP and OGRN and checks that they are consistent:
+// OGRN is used with a juridical INN and OGRNIP with a physical one, the INN and OGRN region codes are the same,
+// the head office KPP is registered in the INN region and KPP is not used with a physical INN.
+// Only the region of the head office KPP is compared, because INN is never changed and an organization
+// which moved to another tax inspection of the region gets a KPP with the new inspection code.
+// Empty KPP and OGRN are not checked. Validation errors are returned as is,
+// mismatches are returned as *ConsistencyError.
+func CheckOrganization(inn, kpp, ogrn string) error {
+	validator := NewValidator(inn, 0)
+	if err := validator.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		value      = validator.inn
+		kind       = kindOfValid(value)
+		region     = value[:regionLength]
+		mismatches []Mismatch
+	)
+
+	if kpp = strings.TrimSpace(kpp); kpp != "" {
+		if err := ValidateKPP(kpp); err != nil {
+			return err
+		}
+		mismatches = append(mismatches, checkKPP(kpp, kind, region)...)
+	}
+
+	if ogrn = strings.TrimSpace(ogrn); ogrn != "" {
+		m, err := checkOGRN(ogrn, kind, region)
+		if err != nil {
+			return err
+		}
+		mismatches = append(mismatches, m...)
+	}
+
+	if len(mismatches) > 0 {
+		return &ConsistencyError{Mismatches: mismatches}
+	}
+
+	return nil
+}
+
+// checkKPP returns mismatches of the valid KPP with the INN kind and region,
+// tax inspection codes are not compared because they change when an organization moves within the region.
+func checkKPP(kpp string, kind Kind, region string) []Mismatch {
+	if kind == KindPhysical {
+		return []Mismatch{{Field: "kpp", Message: "KPP is not assigned to physical persons"}}
+	}
+
+	kppRegion := kpp[:regionLength]
+	reason := kpp[kppReasonStart:kppReasonEnd]
+
+	// foreign organizations, the largest taxpayers and separate subdivisions can be registered in other regions
+	if kind == KindForeign || kppRegion == interregionalPrefix || reason != KPPReasonHeadOffice {
+		return nil
+	}
+
+	if kppRegion != region {
+		return []Mismatch{{
+			Field:   "kpp",
+			Message: fmt.Sprintf("head office tax office %s is not in the INN region %s", kpp[:taxOfficeLength], region),
+		}}
+	}
+
+	return nil
+}
+
+// checkOGRN validates OGRN or OGRNIP by its length and returns mismatches with the INN kind and region.
+func checkOGRN(ogrn string, kind Kind, region string) ([]Mismatch, error) {
+	var (
+		err          error
+		physicalOGRN = len(ogrn) == OGRNIPLength
+	)
+
+	if physicalOGRN {
+		err = ValidateOGRNIP(ogrn)
+	} else {
+		err = ValidateOGRN(ogrn)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case physicalOGRN && kind != KindPhysical:
+		return []Mismatch{{Field: "ogrn", Message: "OGRNIP is used with a juridical INN"}}, nil
+	case !physicalOGRN && kind == KindPhysical:
+		return []Mismatch{{Field: "ogrn", Message: "OGRN of a legal entity is used with a physical INN"}}, nil
+	}
+
+	// foreign organizations are registered by the interregional inspection
+	if ogrnRegion := ogrn[ogrnRegionStart : ogrnRegionStart+regionLength]; kind != KindForeign && ogrnRegion != region {
+		return []Mismatch{{
+			Field:   "ogrn",
+			Message: fmt.Sprintf("region %s differs from the INN region %s", ogrnRegion, region),
+		}}, nil
+	}
+
+	return nil, nil
+}
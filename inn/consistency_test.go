@@ -0,0 +1,77 @@
+package inn
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheckOrganization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		inn        string
+		kpp        string
+		ogrn       string
+		wantErr    error
+		mismatches []Mismatch
+	}{
+		{name: "organization", inn: "7707083893", kpp: "773601001", ogrn: "1027700132195"},
+		{name: "INN only", inn: "7707083893"},
+		{name: "entrepreneur", inn: "500100732259", ogrn: "304500116000157"},
+		{name: "head office moved within the region", inn: "7707083893", kpp: "771001001", ogrn: "1027700132195"},
+		{name: "branch in another region", inn: "7707083893", kpp: "500143001", ogrn: "1027700132195"},
+		{name: "largest taxpayer", inn: "7707083893", kpp: "997750001"},
+		{name: "foreign organization", inn: "9909123454", kpp: "773851001", ogrn: "1027700132195"},
+		{name: "invalid INN", inn: "7707083892", ogrn: "1027700132195", wantErr: ErrInnChecksum},
+		{name: "invalid KPP", inn: "7707083893", kpp: "77360100", wantErr: ErrKppLength},
+		{name: "invalid OGRN", inn: "7707083893", ogrn: "1027700132194", wantErr: ErrOgrnChecksum},
+		{
+			name: "head office in another region", inn: "7707083893", kpp: "500101001",
+			wantErr:    ErrInconsistentRequisites,
+			mismatches: []Mismatch{{Field: "kpp", Message: "head office tax office 5001 is not in the INN region 77"}},
+		},
+		{
+			name: "OGRN region", inn: "7707083893", ogrn: "1025000000002",
+			wantErr:    ErrInconsistentRequisites,
+			mismatches: []Mismatch{{Field: "ogrn", Message: "region 50 differs from the INN region 77"}},
+		},
+		{
+			name: "OGRNIP for juridical INN", inn: "7707083893", ogrn: "304770116000156",
+			wantErr:    ErrInconsistentRequisites,
+			mismatches: []Mismatch{{Field: "ogrn", Message: "OGRNIP is used with a juridical INN"}},
+		},
+		{
+			name: "physical INN with KPP and OGRN", inn: "500100732259", kpp: "500101001", ogrn: "1025000000002",
+			wantErr: ErrInconsistentRequisites,
+			mismatches: []Mismatch{
+				{Field: "kpp", Message: "KPP is not assigned to physical persons"},
+				{Field: "ogrn", Message: "OGRN of a legal entity is used with a physical INN"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckOrganization(tt.inn, tt.kpp, tt.ogrn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckOrganization() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var cErr *ConsistencyError
+			if !errors.As(err, &cErr) {
+				if tt.mismatches != nil {
+					t.Errorf("CheckOrganization() error = %v, want mismatches %v", err, tt.mismatches)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(cErr.Mismatches, tt.mismatches) {
+				t.Errorf("Mismatches = %+v, want %+v", cErr.Mismatches, tt.mismatches)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package inn
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrorKind is a kind of validation error.
+type ErrorKind string
+
+const (
+	// ErrorKindLength is a kind of error for an invalid identifier length.
+	ErrorKindLength ErrorKind = "length"
+	// ErrorKindFormat is a kind of error for a non-digit character.
+	ErrorKindFormat ErrorKind = "format"
+	// ErrorKindChecksum is a kind of error for an invalid check digit.
+	ErrorKindChecksum ErrorKind = "checksum"
+	// ErrorKindTaxOffice is a kind of error for a tax office code which is not found in the registry.
+	ErrorKindTaxOffice ErrorKind = "tax_office"
+)
+
+// ValidationError is a detailed validation error.
+// Position is a 1-based position of the offending character or 0 if it is not applicable,
+// Expected and Actual are the expected and actual digits (or lengths for ErrorKindLength).
+type ValidationError struct {
+	Err      error
+	Kind     ErrorKind
+	Position int
+	Expected string
+	Actual   string
+	Message  string
+}
+
+// Error returns a string representation of the validation error.
+func (e *ValidationError) Error() string {
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Message
+}
+
+// Unwrap returns the base error, so errors.Is can be used with sentinel errors like ErrInnChecksum.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// AsValidationError returns the validation error from the error chain if it exists.
+func AsValidationError(err error) (*ValidationError, bool) {
+	var vErr *ValidationError
+	if errors.As(err, &vErr) {
+		return vErr, true
+	}
+	return nil, false
+}
+
+// ErrorKindOf returns a kind of the validation error from ValidationError or ErrInnTaxOffice,
+// it is empty for nil and other errors.
+func ErrorKindOf(err error) ErrorKind {
+	if vErr, ok := AsValidationError(err); ok {
+		return vErr.Kind
+	}
+
+	if errors.Is(err, ErrInnTaxOffice) {
+		return ErrorKindTaxOffice
+	}
+	return ""
+}
+
+// newLengthError returns a validation error for an invalid length.
+func newLengthError(err error, expected string, actual int, message string) *ValidationError {
+	return &ValidationError{
+		Err:      err,
+		Kind:     ErrorKindLength,
+		Expected: expected,
+		Actual:   strconv.Itoa(actual),
+		Message:  message,
+	}
+}
+
+// newFormatError returns a validation error for a non-digit character at the 1-based position.
+func newFormatError(err error, position int, actual rune) *ValidationError {
+	return &ValidationError{
+		Err:      err,
+		Kind:     ErrorKindFormat,
+		Position: position,
+		Expected: "0-9",
+		Actual:   string(actual),
+		Message:  "not a decimal number '" + string(actual) + "' at position " + strconv.Itoa(position),
+	}
+}
+
+// newChecksumError returns a validation error for an invalid check digit at the 1-based position.
+func newChecksumError(err error, position, expected, actual int, message string) *ValidationError {
+	return &ValidationError{
+		Err:      err,
+		Kind:     ErrorKindChecksum,
+		Position: position,
+		Expected: strconv.Itoa(expected),
+		Actual:   strconv.Itoa(actual),
+		Message:  message,
+	}
+}
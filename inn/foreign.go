@@ -0,0 +1,78 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// kioLength is the length of a foreign organization code (KIO) in INN after the 9909 prefix.
+	kioLength = 5
+	// maxKIO is the maximum KIO value.
+	maxKIO = 99999
+	// maxJuridicalDraws is the maximum number of juridical INN draws with the foreign organization prefix.
+	maxJuridicalDraws = 100
+)
+
+// ErrInnKIO is an error indicating an invalid foreign organization code (KIO) in INN.
+var ErrInnKIO = errors.New("invalid INN KIO code")
+
+// validateKIO checks the foreign organization code (KIO) of the juridical INN with the 9909 prefix,
+// it should be five digits except 00000.
+func validateKIO(value string) error {
+	kio := value[len(foreignPrefix) : len(foreignPrefix)+kioLength]
+	if n, err := strconv.Atoi(kio); err != nil || n == 0 {
+		return &ValidationError{
+			Err:      ErrInnKIO,
+			Kind:     ErrorKindFormat,
+			Position: len(foreignPrefix) + 1,
+			Expected: fmt.Sprintf("00001-%d", maxKIO),
+			Actual:   kio,
+			Message:  fmt.Sprintf("foreign organization code %s is not assigned", kio),
+		}
+	}
+	return nil
+}
+
+// hasForeignPrefix returns true if the INN digits start with the 9909 prefix of foreign organizations.
+func hasForeignPrefix(digits []int) bool {
+	for i, c := range foreignPrefix {
+		if digits[i] != int(c-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+// Foreign generates a valid 10-digit INN of a foreign organization registered in Russia,
+// it is the 9909 prefix, a random foreign organization code (KIO) and a check digit.
+// Region and tax office options are not used.
+func (g *Generator) Foreign() (string, error) {
+	g.mu.Lock()
+	n, err := randomInt(g.random(), maxKIO)
+	g.mu.Unlock()
+
+	if err != nil {
+		return "", errors.Join(ErrInnGeneration, err)
+	}
+
+	digits, err := parseDigits(fmt.Sprintf("%s%05d0", foreignPrefix, n+1), ErrInnFormat)
+	if err != nil {
+		return "", errors.Join(ErrInnGeneration, err)
+	}
+
+	d, err := calculateControlValue(weightsJuridical, digits)
+	if err != nil {
+		return "", errors.Join(ErrInnGeneration, err)
+	}
+
+	digits[9] = d
+
+	return digitsToString(digits), nil
+}
+
+// GenerateForeignINN generates a valid 10-digit INN of a foreign organization registered in Russia.
+func GenerateForeignINN() (string, error) {
+	return defaultGenerator.Foreign()
+}
@@ -0,0 +1,120 @@
+package inn
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidator_ValidateForeign(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inn     string
+		wantErr error
+	}{
+		{name: "valid", inn: "9909123454"},
+		{name: "checksum", inn: "9909123455", wantErr: ErrInnChecksum},
+		{name: "zero KIO", inn: "9909000004", wantErr: ErrInnKIO},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewValidator(tt.inn, 0).Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !errors.Is(err, ErrInnKIO) {
+				return
+			}
+
+			ve, ok := AsValidationError(err)
+			if !ok || ve.Kind != ErrorKindFormat || ve.Position != 5 || ve.Actual != "00000" {
+				t.Errorf("ValidationError = %+v, want KIO format error at position 5", ve)
+			}
+		})
+	}
+}
+
+func TestGenerateForeignINN(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		value, err := GenerateForeignINN()
+		if err != nil {
+			t.Fatalf("GenerateForeignINN() error = %v", err)
+		}
+
+		if !strings.HasPrefix(value, foreignPrefix) {
+			t.Errorf("GenerateForeignINN() = %s, want prefix %s", value, foreignPrefix)
+		}
+
+		info, err := Parse(value)
+		if err != nil {
+			t.Fatalf("Parse(%s) error = %v", value, err)
+		}
+
+		if info.Kind != KindForeign || info.KIO != value[4:9] {
+			t.Errorf("Parse(%s) = %+v, want foreign organization", value, info)
+		}
+	}
+}
+
+func TestGenerator_GenerateForeign(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(8), WithKind(KindForeign), WithRegion(77))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	value, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if got := FmtResult(value, NewValidator(value, 0).Validate()); !strings.HasSuffix(got, "(foreign organization)") {
+		t.Errorf("FmtResult() = %q, want foreign organization", got)
+	}
+}
+
+func TestGenerator_JuridicalForeignPrefix(t *testing.T) {
+	t.Parallel()
+
+	// every random digit is one byte, a juridical INN takes 9 digits, the random office is 09
+	foreign := []byte{0, 0, 0, 9, 0, 0, 0, 0, 0}
+	tests := []struct {
+		name    string
+		random  []byte
+		want    string
+		wantErr error
+	}{
+		{name: "drawn again", random: append(foreign, bytes.Repeat([]byte{1}, JuridicalLength-1)...), want: "9911111110"},
+		{name: "always foreign", random: bytes.Repeat(foreign, maxJuridicalDraws), wantErr: ErrInnGeneration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := NewGenerator(WithReader(bytes.NewReader(tt.random)), WithKind(KindJuridical), WithRegion(99))
+			if err != nil {
+				t.Fatalf("NewGenerator() error = %v", err)
+			}
+
+			got, err := g.Juridical()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Juridical() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Juridical() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,12 +2,24 @@ package inn
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	mathrand "math/rand/v2"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxCode is the maximum value of two-digit region and tax office codes.
+	maxCode = 99
+	// seededLatestYear is the upper bound of random years for seeded generators,
+	// it does not depend on the current date, so seeded sequences are the same every year.
+	seededLatestYear = 2025
 )
 
 var (
@@ -15,17 +27,194 @@ var (
 	maxFirst = big.NewInt(9)  //nolint:gochecknoglobals
 	maxNext  = big.NewInt(10) //nolint:gochecknoglobals
 
+	// defaultGenerator is a generator with a cryptographically secure random source.
+	defaultGenerator = &Generator{reader: rand.Reader, kind: KindPhysical} //nolint:gochecknoglobals
+
 	// ErrInnGeneration is an error indicating an error during INN generation.
 	ErrInnGeneration = errors.New("failed to generate INN")
+	// ErrGeneratorOption is an error indicating an invalid generator option.
+	ErrGeneratorOption = errors.New("invalid generator option")
 )
 
-// GeneratePhysicalINN generates a valid 12-digit INN for a physical person.
-func GeneratePhysicalINN() (string, error) {
-	digits, err := generateINN(PhysicalLength-2, PhysicalLength, rand.Reader)
+// Option is a generator configuration option.
+type Option func(*Generator) error
+
+// WithReader sets a random source for the generator.
+func WithReader(reader io.Reader) Option {
+	return func(g *Generator) error {
+		if reader == nil {
+			return fmt.Errorf("%w: nil random reader", ErrGeneratorOption)
+		}
+		g.reader = reader
+		return nil
+	}
+}
+
+// WithSeed sets a deterministic random source initialized by the seed,
+// so generators with the same seed produce the same INN sequences.
+// Random registration and birth years of seeded generators are limited by 2025 instead of the current year,
+// so the sequences do not change over time. It must not be used when unpredictable values are required.
+func WithSeed(seed uint64) Option {
+	return func(g *Generator) error {
+		var key [32]byte
+		binary.LittleEndian.PutUint64(key[:8], seed)
+		g.reader = mathrand.NewChaCha8(key)
+		g.latestYear = seededLatestYear
+		return nil
+	}
+}
+
+// WithKind sets a kind of INNs returned by Generator.Generate.
+func WithKind(kind Kind) Option {
+	return func(g *Generator) error {
+		if kind != KindPhysical && kind != KindJuridical && kind != KindForeign {
+			return fmt.Errorf("%w: %w %q", ErrGeneratorOption, ErrUnknownKind, kind)
+		}
+		g.kind = kind
+		return nil
+	}
+}
+
+// WithRegion sets a federal subject code (01-99) for the first two INN digits,
+// for example 77 for Moscow or 78 for St. Petersburg.
+func WithRegion(region int) Option {
+	return func(g *Generator) error {
+		if region < 1 || region > maxCode {
+			return fmt.Errorf("%w: region code %d is out of range [1, %d]", ErrGeneratorOption, region, maxCode)
+		}
+		g.region = region
+		return nil
+	}
+}
+
+// WithTaxOffice sets a tax inspection code (01-99) for the 3rd and 4th INN digits,
+// it can be used only together with WithRegion. Codes 99 and 09 are the foreign organization prefix,
+// they are allowed only with WithKind(KindForeign).
+func WithTaxOffice(office int) Option {
+	return func(g *Generator) error {
+		if office < 1 || office > maxCode {
+			return fmt.Errorf("%w: tax office code %d is out of range [1, %d]", ErrGeneratorOption, office, maxCode)
+		}
+		g.office = office
+		return nil
+	}
+}
+
+// WithRegistrationYear sets a registration year for the 2nd and 3rd digits of generated OGRN and OGRNIP,
+// it should be in range from MinRegistrationYear to the current year.
+func WithRegistrationYear(year int) Option {
+	return func(g *Generator) error {
+		if maxYear := currentYear(); year < MinRegistrationYear || year > maxYear {
+			return fmt.Errorf(
+				"%w: registration year %d is out of range [%d, %d]",
+				ErrGeneratorOption, year, MinRegistrationYear, maxYear,
+			)
+		}
+		g.year = year
+		return nil
+	}
+}
+
+// Generator generates valid INNs. It is safe for concurrent use.
+// The zero value generates INNs of physical persons with a cryptographically secure random source.
+type Generator struct {
+	mu sync.Mutex
+	// reader is a random source, nil means crypto/rand.Reader.
+	reader io.Reader
+	kind   Kind
+	region int
+	office int
+	year   int
+	// latestYear is the upper bound of random years, 0 means the current year.
+	latestYear int
+}
+
+// NewGenerator creates a new generator, by default it uses a cryptographically
+// secure random source and generates INNs for physical persons.
+func NewGenerator(options ...Option) (*Generator, error) {
+	g := &Generator{reader: rand.Reader, kind: KindPhysical}
+
+	for _, option := range options {
+		if err := option(g); err != nil {
+			return nil, err
+		}
+	}
+
+	if g.office != 0 && g.region == 0 {
+		return nil, fmt.Errorf("%w: tax office code requires region code", ErrGeneratorOption)
+	}
+
+	if code := fmt.Sprintf("%02d%02d", g.region, g.office); code == foreignPrefix && g.kind != KindForeign {
+		return nil, fmt.Errorf(
+			"%w: region and tax office codes %s are the foreign organization prefix, use the foreign kind",
+			ErrGeneratorOption, code,
+		)
+	}
+
+	return g, nil
+}
+
+// random returns the random source of the generator.
+func (g *Generator) random() io.Reader {
+	if g.reader == nil {
+		return rand.Reader
+	}
+	return g.reader
+}
+
+// childFor returns a generator of the kind with fixed region and tax office codes,
+// it shares the random source and years of the parent. The caller must hold the generator lock,
+// so the child generator can use the same random source without its own lock.
+func (g *Generator) childFor(kind Kind, region, office int) *Generator {
+	return &Generator{
+		reader: g.reader, kind: kind, region: region, office: office, year: g.year, latestYear: g.latestYear,
+	}
+}
+
+// Region returns a region code of generated INNs, 0 means a random one.
+func (g *Generator) Region() int {
+	return g.region
+}
+
+// TaxOffice returns a tax office code of generated INNs, 0 means a random one.
+func (g *Generator) TaxOffice() int {
+	return g.office
+}
+
+// RegistrationYear returns a registration year of generated OGRN and OGRNIP, 0 means a random one.
+func (g *Generator) RegistrationYear() int {
+	return g.year
+}
+
+// Kind returns a kind of INNs returned by Generate.
+func (g *Generator) Kind() Kind {
+	return g.kind
+}
+
+// Generate generates a valid INN of the generator's kind.
+func (g *Generator) Generate() (string, error) {
+	switch g.kind {
+	case KindJuridical:
+		return g.Juridical()
+	case KindForeign:
+		return g.Foreign()
+	default:
+		return g.Physical()
+	}
+}
+
+// Physical generates a valid 12-digit INN for a physical person.
+func (g *Generator) Physical() (string, error) {
+	g.mu.Lock()
+	digits, err := generateINN(PhysicalLength-2, PhysicalLength, g.random())
+	g.mu.Unlock()
+
 	if err != nil {
 		return "", errors.Join(ErrInnGeneration, err)
 	}
 
+	g.setPrefix(digits)
+
 	d, err := calculateControlValue(weightsPhysical1, digits)
 	if err != nil {
 		return "", errors.Join(ErrInnGeneration, err)
@@ -43,9 +232,12 @@ func GeneratePhysicalINN() (string, error) {
 	return digitsToString(digits), nil
 }
 
-// GenerateJuridicalINN generates a valid 10-digit INN for a juridical person.
-func GenerateJuridicalINN() (string, error) {
-	digits, err := generateINN(JuridicalLength-1, JuridicalLength, rand.Reader)
+// Juridical generates a valid 10-digit INN for a juridical person.
+func (g *Generator) Juridical() (string, error) {
+	g.mu.Lock()
+	digits, err := g.juridicalDigits()
+	g.mu.Unlock()
+
 	if err != nil {
 		return "", errors.Join(ErrInnGeneration, err)
 	}
@@ -60,6 +252,45 @@ func GenerateJuridicalINN() (string, error) {
 	return digitsToString(digits), nil
 }
 
+// juridicalDigits returns random juridical INN digits with the configured prefix,
+// digits with the 9909 prefix of foreign organizations are drawn again.
+// The caller must hold the generator lock.
+func (g *Generator) juridicalDigits() ([]int, error) {
+	for range maxJuridicalDraws {
+		digits, err := generateINN(JuridicalLength-1, JuridicalLength, g.random())
+		if err != nil {
+			return nil, err
+		}
+
+		g.setPrefix(digits)
+		if !hasForeignPrefix(digits) {
+			return digits, nil
+		}
+	}
+	return nil, fmt.Errorf("foreign organization prefix %s is drawn %d times", foreignPrefix, maxJuridicalDraws)
+}
+
+// setPrefix replaces random region and tax office digits by the configured ones.
+func (g *Generator) setPrefix(digits []int) {
+	if g.region != 0 {
+		digits[0], digits[1] = g.region/10, g.region%10
+	}
+
+	if g.office != 0 {
+		digits[2], digits[3] = g.office/10, g.office%10
+	}
+}
+
+// GeneratePhysicalINN generates a valid 12-digit INN for a physical person.
+func GeneratePhysicalINN() (string, error) {
+	return defaultGenerator.Physical()
+}
+
+// GenerateJuridicalINN generates a valid 10-digit INN for a juridical person.
+func GenerateJuridicalINN() (string, error) {
+	return defaultGenerator.Juridical()
+}
+
 func generateINN(length, capLen int, reader io.Reader) ([]int, error) {
 	if capLen != PhysicalLength && capLen != JuridicalLength {
 		return nil, fmt.Errorf("invalid INN length: %d", length)
@@ -100,3 +331,41 @@ func digitsToString(digits []int) string {
 
 	return inn.String()
 }
+
+// randomInt returns a random integer in range [0, n).
+func randomInt(reader io.Reader, n int) (int, error) {
+	d, err := rand.Int(reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate random number: %w", err)
+	}
+	return int(d.Int64()), nil
+}
+
+// randomDigits returns n random decimal digits.
+func randomDigits(reader io.Reader, n int) ([]int, error) {
+	digits := make([]int, n)
+
+	for i := range digits {
+		d, err := rand.Int(reader, maxNext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate digit %d: %w", i, err)
+		}
+
+		digits[i] = int(d.Int64())
+	}
+
+	return digits, nil
+}
+
+// currentYear returns the current year, it is the upper bound of registration years.
+func currentYear() int {
+	return time.Now().Year()
+}
+
+// maxRandomYear returns the upper bound of random registration and birth years.
+func (g *Generator) maxRandomYear() int {
+	if g.latestYear != 0 {
+		return g.latestYear
+	}
+	return currentYear()
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"unicode"
 )
@@ -395,3 +398,292 @@ func TestGenerateINN_ChecksumEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestNewGenerator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		options  []Option
+		wantKind Kind
+		wantErr  error
+	}{
+		{
+			name:     "default options",
+			wantKind: KindPhysical,
+		},
+		{
+			name:     "juridical kind",
+			options:  []Option{WithKind(KindJuridical)},
+			wantKind: KindJuridical,
+		},
+		{
+			name:     "foreign kind",
+			options:  []Option{WithKind(KindForeign)},
+			wantKind: KindForeign,
+		},
+		{
+			name:     "seed and physical kind",
+			options:  []Option{WithSeed(42), WithKind(KindPhysical)},
+			wantKind: KindPhysical,
+		},
+		{
+			name:    "unknown kind",
+			options: []Option{WithKind(KindUnknown)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "nil reader",
+			options: []Option{WithReader(nil)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "juridical foreign prefix",
+			options: []Option{WithKind(KindJuridical), WithRegion(99), WithTaxOffice(9)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "physical foreign prefix",
+			options: []Option{WithRegion(99), WithTaxOffice(9)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:     "foreign prefix of foreign kind",
+			options:  []Option{WithRegion(99), WithTaxOffice(9), WithKind(KindForeign)},
+			wantKind: KindForeign,
+		},
+		{
+			name:     "interregional inspection",
+			options:  []Option{WithKind(KindJuridical), WithRegion(99), WithTaxOffice(1)},
+			wantKind: KindJuridical,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := NewGenerator(tt.options...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewGenerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if k := g.Kind(); k != tt.wantKind {
+				t.Errorf("Kind() = %v, want %v", k, tt.wantKind)
+			}
+
+			value, err := g.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if k := kindOfValid(value); k != tt.wantKind {
+				t.Errorf("Generate() = %s of kind %v, want %v", value, k, tt.wantKind)
+			}
+
+			if err = NewValidator(value, 0).Validate(); err != nil {
+				t.Errorf("Generated INN %s failed validation: %v", value, err)
+			}
+		})
+	}
+}
+
+func TestGenerator_WithSeed(t *testing.T) {
+	t.Parallel()
+	const iterations = 20
+
+	generate := func(seed uint64) []string {
+		g, err := NewGenerator(WithSeed(seed))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+
+		values := make([]string, 0, 2*iterations)
+		for range iterations {
+			p, pErr := g.Physical()
+			if pErr != nil {
+				t.Fatalf("Physical() error = %v", pErr)
+			}
+
+			j, jErr := g.Juridical()
+			if jErr != nil {
+				t.Fatalf("Juridical() error = %v", jErr)
+			}
+
+			values = append(values, p, j)
+		}
+		return values
+	}
+
+	first, second, other := generate(1), generate(1), generate(2)
+
+	if !slices.Equal(first, second) {
+		t.Errorf("generators with the same seed returned different INNs:\n%v\n%v", first, second)
+	}
+
+	if slices.Equal(first, other) {
+		t.Errorf("generators with different seeds returned the same INNs: %v", first)
+	}
+}
+
+func TestGenerator_WithReader(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithReader(&errorReader{}))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if _, err = g.Physical(); !errors.Is(err, ErrInnGeneration) {
+		t.Errorf("Physical() error = %v, want %v", err, ErrInnGeneration)
+	}
+
+	if _, err = g.Juridical(); !errors.Is(err, ErrInnGeneration) {
+		t.Errorf("Juridical() error = %v, want %v", err, ErrInnGeneration)
+	}
+}
+
+func TestGenerator_ZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var g Generator
+
+	value, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if err = NewValidator(value, PhysicalLength).Validate(); err != nil {
+		t.Errorf("Generate() = %s, error = %v", value, err)
+	}
+
+	if value, err = g.Juridical(); err != nil {
+		t.Fatalf("Juridical() error = %v", err)
+	}
+	if err = NewValidator(value, JuridicalLength).Validate(); err != nil {
+		t.Errorf("Juridical() = %s, error = %v", value, err)
+	}
+
+	if _, err = g.Organization(); err != nil {
+		t.Errorf("Organization() error = %v", err)
+	}
+
+	if _, err = g.Person(true); err != nil {
+		t.Errorf("Person() error = %v", err)
+	}
+}
+
+func TestGenerator_WithRegion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		options    []Option
+		wantPrefix string
+		wantErr    error
+	}{
+		{
+			name:       "moscow",
+			options:    []Option{WithRegion(77)},
+			wantPrefix: "77",
+		},
+		{
+			name:       "adygea with leading zero",
+			options:    []Option{WithRegion(1)},
+			wantPrefix: "01",
+		},
+		{
+			name:       "st. petersburg and tax office",
+			options:    []Option{WithRegion(78), WithTaxOffice(5)},
+			wantPrefix: "7805",
+		},
+		{
+			name:    "zero region",
+			options: []Option{WithRegion(0)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "too big region",
+			options: []Option{WithRegion(100)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "zero tax office",
+			options: []Option{WithRegion(77), WithTaxOffice(0)},
+			wantErr: ErrGeneratorOption,
+		},
+		{
+			name:    "tax office without region",
+			options: []Option{WithTaxOffice(7)},
+			wantErr: ErrGeneratorOption,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := NewGenerator(tt.options...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewGenerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			for range 20 {
+				for _, generate := range []func() (string, error){g.Physical, g.Juridical} {
+					value, genErr := generate()
+					if genErr != nil {
+						t.Fatalf("generate error = %v", genErr)
+					}
+
+					if !strings.HasPrefix(value, tt.wantPrefix) {
+						t.Errorf("generated INN %s, want prefix %s", value, tt.wantPrefix)
+					}
+
+					if err = NewValidator(value, 0).Validate(); err != nil {
+						t.Errorf("Generated INN %s failed validation: %v", value, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWithSeed_LatestYear(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(3))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if year := g.maxRandomYear(); year != seededLatestYear {
+		t.Fatalf("maxRandomYear() = %d, want %d", year, seededLatestYear)
+	}
+
+	for range 200 {
+		ogrn, genErr := g.OGRN()
+		if genErr != nil {
+			t.Fatalf("OGRN() error = %v", genErr)
+		}
+
+		if year := ogrn[1:3]; year > strconv.Itoa(seededLatestYear%100) {
+			t.Errorf("OGRN() = %s, registration year is after %d", ogrn, seededLatestYear)
+		}
+
+		p, genErr := g.Person(true)
+		if genErr != nil {
+			t.Fatalf("Person() error = %v", genErr)
+		}
+
+		if year := p.BirthDate.Year(); year > seededLatestYear-minPersonAge {
+			t.Errorf("Person() birth date %v is after %d", p.BirthDate, seededLatestYear-minPersonAge)
+		}
+	}
+}
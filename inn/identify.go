@@ -0,0 +1,98 @@
+package inn
+
+import (
+	"slices"
+	"strings"
+)
+
+// IdentifierType is a type of Russian requisites identifier.
+type IdentifierType string
+
+const (
+	// IdentifierINN10 is a 10-digit INN of a juridical person.
+	IdentifierINN10 IdentifierType = "inn-10"
+	// IdentifierINN12 is a 12-digit INN of a physical person.
+	IdentifierINN12 IdentifierType = "inn-12"
+	// IdentifierOGRN is a 13-digit OGRN of a legal entity.
+	IdentifierOGRN IdentifierType = "ogrn"
+	// IdentifierOGRNIP is a 15-digit OGRNIP of an individual entrepreneur.
+	IdentifierOGRNIP IdentifierType = "ogrnip"
+	// IdentifierSNILS is an 11-digit insurance account number.
+	IdentifierSNILS IdentifierType = "snils"
+	// IdentifierBIK is a 9-digit bank identification code.
+	IdentifierBIK IdentifierType = "bik"
+	// IdentifierKPP is a 9-character tax registration reason code.
+	IdentifierKPP IdentifierType = "kpp"
+	// IdentifierOKPO is an 8 or 10-digit OKPO code.
+	IdentifierOKPO IdentifierType = "okpo"
+)
+
+// snilsFormattedLength is the length of SNILS in the canonical "XXX-XXX-XXX YY" form.
+const snilsFormattedLength = 14
+
+// identifiers are known identifier types with their lengths and validators in the order of Identify results.
+var identifiers = []struct { //nolint:gochecknoglobals
+	kind     IdentifierType
+	lengths  []int
+	validate func(string) error
+}{
+	{kind: IdentifierINN10, lengths: []int{JuridicalLength}, validate: validateINN(JuridicalLength)},
+	{kind: IdentifierINN12, lengths: []int{PhysicalLength}, validate: validateINN(PhysicalLength)},
+	{kind: IdentifierOGRN, lengths: []int{OGRNLength}, validate: ValidateOGRN},
+	{kind: IdentifierOGRNIP, lengths: []int{OGRNIPLength}, validate: ValidateOGRNIP},
+	{kind: IdentifierSNILS, lengths: []int{SNILSLength, snilsFormattedLength}, validate: ValidateSNILS},
+	{kind: IdentifierBIK, lengths: []int{BIKLength}, validate: ValidateBIK},
+	{kind: IdentifierKPP, lengths: []int{KPPLength}, validate: ValidateKPP},
+	{kind: IdentifierOKPO, lengths: []int{OKPOLength, OKPOIPLength}, validate: ValidateOKPO},
+}
+
+// Candidate is a possible identifier type of a string with its validation result.
+type Candidate struct {
+	Type  IdentifierType `json:"type"`
+	Valid bool           `json:"valid"`
+	Err   error          `json:"-"`
+	Error string         `json:"error,omitempty"`
+}
+
+// Identify returns identifier types which the string could be by its length, each candidate is validated.
+// Valid candidates go first, BIK and KPP have no checksum, so they are valid if the format is correct.
+// The result is empty if no identifier has the string length.
+func Identify(s string) []Candidate {
+	value := strings.TrimSpace(s)
+	n := len(value)
+
+	candidates := make([]Candidate, 0, 2) //nolint:mnd // at most two identifiers have the same length
+	for _, identifier := range identifiers {
+		if !slices.Contains(identifier.lengths, n) {
+			continue
+		}
+
+		c := Candidate{Type: identifier.kind, Err: identifier.validate(value)}
+		c.Valid = c.Err == nil
+		if !c.Valid {
+			c.Error = c.Err.Error()
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		switch {
+		case a.Valid == b.Valid:
+			return 0
+		case a.Valid:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return candidates
+}
+
+// validateINN returns a validation function of INNs with the required length.
+func validateINN(length int) func(string) error {
+	return func(value string) error {
+		return NewValidator(value, length).Validate()
+	}
+}
@@ -0,0 +1,111 @@
+package inn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIdentify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  []Candidate
+	}{
+		{
+			name:  "juridical INN",
+			value: "7707083893",
+			want:  []Candidate{{Type: IdentifierINN10, Valid: true}, {Type: IdentifierOKPO}},
+		},
+		{
+			name:  "entrepreneur OKPO",
+			value: "3681930368",
+			want:  []Candidate{{Type: IdentifierOKPO, Valid: true}, {Type: IdentifierINN10}},
+		},
+		{
+			name:  "physical INN",
+			value: " 500100732259 ",
+			want:  []Candidate{{Type: IdentifierINN12, Valid: true}},
+		},
+		{
+			name:  "OGRN",
+			value: "1027700132195",
+			want:  []Candidate{{Type: IdentifierOGRN, Valid: true}},
+		},
+		{
+			name:  "OGRNIP",
+			value: "304500116000157",
+			want:  []Candidate{{Type: IdentifierOGRNIP, Valid: true}},
+		},
+		{
+			name:  "SNILS",
+			value: "11223344595",
+			want:  []Candidate{{Type: IdentifierSNILS, Valid: true}},
+		},
+		{
+			name:  "formatted SNILS",
+			value: "112-233-445 95",
+			want:  []Candidate{{Type: IdentifierSNILS, Valid: true}},
+		},
+		{
+			name:  "BIK and KPP",
+			value: "044525225",
+			want:  []Candidate{{Type: IdentifierBIK, Valid: true}, {Type: IdentifierKPP, Valid: true}},
+		},
+		{
+			name:  "KPP only",
+			value: "770701001",
+			want:  []Candidate{{Type: IdentifierKPP, Valid: true}, {Type: IdentifierBIK}},
+		},
+		{
+			name:  "legal entity OKPO",
+			value: "00032537",
+			want:  []Candidate{{Type: IdentifierOKPO, Valid: true}},
+		},
+		{
+			name:  "invalid OGRN",
+			value: "1027700132194",
+			want:  []Candidate{{Type: IdentifierOGRN}},
+		},
+		{
+			name:  "unknown length",
+			value: "1234567",
+			want:  []Candidate{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Identify(tt.value)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Identify() = %+v, want %+v", got, tt.want)
+			}
+
+			for i, c := range got {
+				if c.Type != tt.want[i].Type || c.Valid != tt.want[i].Valid {
+					t.Errorf("Identify()[%d] = %+v, want %+v", i, c, tt.want[i])
+				}
+
+				if c.Valid != (c.Err == nil) || c.Valid != (c.Error == "") {
+					t.Errorf("Identify()[%d] = %+v, inconsistent error", i, c)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentify_Errors(t *testing.T) {
+	t.Parallel()
+
+	got := Identify("7707083892")
+	if len(got) != 2 {
+		t.Fatalf("Identify() = %+v, want 2 candidates", got)
+	}
+
+	if !errors.Is(got[0].Err, ErrInnChecksum) && !errors.Is(got[1].Err, ErrInnChecksum) {
+		t.Errorf("Identify() = %+v, want INN checksum error", got)
+	}
+}
@@ -0,0 +1,93 @@
+package inn
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// foreignPrefix is a prefix of juridical INNs of foreign organizations.
+	foreignPrefix = "9909"
+
+	regionLength    = 2
+	taxOfficeLength = 4
+)
+
+// Info is a structured information decoded from a valid INN.
+// KIO is a foreign organization code, it is set only for the foreign kind.
+type Info struct {
+	INN           string `json:"inn"`
+	Kind          Kind   `json:"kind"`
+	Region        string `json:"region"`
+	RegionName    string `json:"region_name,omitempty"`
+	TaxOffice     string `json:"tax_office"`
+	TaxOfficeName string `json:"tax_office_name,omitempty"`
+	Serial        string `json:"serial"`
+	KIO           string `json:"kio,omitempty"`
+	Check         string `json:"check"`
+}
+
+// Parse validates the INN and decodes it into a structured information.
+func Parse(inn string) (*Info, error) {
+	validator := NewValidator(inn, 0)
+	if err := validator.Validate(); err != nil {
+		return nil, err
+	}
+
+	value := validator.inn
+	checkLength := 1
+
+	if len(value) == PhysicalLength {
+		checkLength = 2
+	}
+
+	serialEnd := len(value) - checkLength
+	info := &Info{
+		INN:       value,
+		Kind:      kindOfValid(value),
+		Region:    value[:regionLength],
+		TaxOffice: value[:taxOfficeLength],
+		Serial:    value[taxOfficeLength:serialEnd],
+		Check:     value[serialEnd:],
+	}
+
+	if info.Kind == KindForeign {
+		info.KIO = info.Serial
+	}
+
+	info.RegionName, _ = RegionName(info.Region)
+	info.TaxOfficeName, _ = TaxOfficeName(info.TaxOffice)
+
+	return info, nil
+}
+
+// String returns a string representation of the INN information, KIO is added for foreign organizations.
+func (info *Info) String() string {
+	serial := info.Serial
+	if info.KIO != "" {
+		serial += ", kio=" + info.KIO
+	}
+
+	return fmt.Sprintf(
+		"INN %s: kind=%s, region=%s, tax office=%s, serial=%s, check=%s",
+		info.INN, info.Kind, withName(info.Region, info.RegionName),
+		withName(info.TaxOffice, info.TaxOfficeName), serial, info.Check,
+	)
+}
+
+// withName returns a code with its human-readable name if it is known.
+func withName(code, name string) string {
+	if name == "" {
+		return code
+	}
+	return code + " (" + name + ")"
+}
+
+// kindOfValid returns a kind of the valid INN including foreign organizations.
+func kindOfValid(value string) Kind {
+	kind := KindOf(value)
+	if kind == KindJuridical && strings.HasPrefix(value, foreignPrefix) {
+		return KindForeign
+	}
+	return kind
+}
@@ -0,0 +1,126 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inn     string
+		want    Info
+		wantErr error
+	}{
+		{
+			name: "juridical",
+			inn:  "7707083893",
+			want: Info{
+				INN: "7707083893", Kind: KindJuridical, Region: "77", RegionName: "г. Москва",
+				TaxOffice: "7707", TaxOfficeName: "ИФНС России № 7 по г. Москве", Serial: "08389", Check: "3",
+			},
+		},
+		{
+			name: "physical with whitespace",
+			inn:  " 500100732259 ",
+			want: Info{
+				INN: "500100732259", Kind: KindPhysical, Region: "50", RegionName: "Московская область",
+				TaxOffice: "5001", Serial: "007322", Check: "59",
+			},
+		},
+		{
+			name: "foreign organization",
+			inn:  "9909123454",
+			want: Info{
+				INN: "9909123454", Kind: KindForeign, Region: "99", RegionName: "Межрегиональные инспекции ФНС России",
+				TaxOffice: "9909", TaxOfficeName: "Межрегиональная инспекция ФНС России (иностранные организации, КИО)",
+				Serial: "12345", KIO: "12345", Check: "4",
+			},
+		},
+		{
+			name: "unknown tax office",
+			inn:  "0199000018",
+			want: Info{
+				INN: "0199000018", Kind: KindJuridical, Region: "01", RegionName: "Республика Адыгея",
+				TaxOffice: "0199", Serial: "00001", Check: "8",
+			},
+		},
+		{
+			name:    "invalid length",
+			inn:     "12345",
+			wantErr: ErrInnLength,
+		},
+		{
+			name:    "invalid checksum",
+			inn:     "7707083892",
+			wantErr: ErrInnChecksum,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.inn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if got != nil {
+					t.Errorf("Parse() = %v, want nil", got)
+				}
+				return
+			}
+
+			if *got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", *got, tt.want)
+			}
+
+			if s := got.String(); !strings.Contains(s, tt.want.INN) || !strings.Contains(s, tt.want.RegionName) {
+				t.Errorf("String() = %q, want to contain INN and region name", s)
+			}
+		})
+	}
+}
+
+func TestInfo_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		inn  string
+		want string
+	}{
+		{
+			name: "juridical",
+			inn:  "5504036333",
+			want: "INN 5504036333: kind=juridical, region=55 (Омская область), tax office=5504, serial=03633, check=3",
+		},
+		{
+			name: "foreign organization",
+			inn:  "9909123454",
+			want: "INN 9909123454: kind=foreign, region=99 (Межрегиональные инспекции ФНС России), " +
+				"tax office=9909 (Межрегиональная инспекция ФНС России (иностранные организации, КИО)), " +
+				"serial=12345, kio=12345, check=4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := Parse(tt.inn)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := info.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -19,8 +19,12 @@ const (
 var (
 	// ErrInnLength is an error indicating an invalid INN length.
 	ErrInnLength = errors.New("invalid INN length")
+	// ErrInnFormat is an error indicating a non-digit character in INN.
+	ErrInnFormat = errors.New("invalid INN format")
 	// ErrInnChecksum is an error indicating an invalid INN checksum.
 	ErrInnChecksum = errors.New("invalid INN checksum")
+	// ErrUnknownKind is an error indicating an unknown kind of INN.
+	ErrUnknownKind = errors.New("unknown INN kind")
 
 	// weights for checksum calculation (from https://www.egrul.ru/test_inn.html)
 	weightsPhysical1 = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8, 0}    //nolint:gochecknoglobals
@@ -28,6 +32,50 @@ var (
 	weightsJuridical = []int{2, 4, 10, 3, 5, 9, 4, 6, 8, 0}       //nolint:gochecknoglobals
 )
 
+// Kind is a kind of taxpayer identified by INN.
+type Kind string
+
+const (
+	// KindUnknown is a kind of INN with unknown length.
+	KindUnknown Kind = ""
+	// KindPhysical is a kind of INN for a physical person.
+	KindPhysical Kind = "physical"
+	// KindJuridical is a kind of INN for a juridical person.
+	KindJuridical Kind = "juridical"
+	// KindForeign is a kind of INN for a foreign organization registered in Russia.
+	KindForeign Kind = "foreign"
+)
+
+// String returns a string representation of the kind.
+func (k Kind) String() string {
+	if k == KindUnknown {
+		return "unknown"
+	}
+	return string(k)
+}
+
+// KindOf returns a kind of INN by its length, the checksum is not validated.
+func KindOf(inn string) Kind {
+	switch utf8.RuneCountInString(strings.TrimSpace(inn)) {
+	case PhysicalLength:
+		return KindPhysical
+	case JuridicalLength:
+		return KindJuridical
+	default:
+		return KindUnknown
+	}
+}
+
+// ParseKind returns a kind by its name.
+func ParseKind(name string) (Kind, error) {
+	switch k := Kind(strings.ToLower(strings.TrimSpace(name))); k {
+	case KindPhysical, KindJuridical, KindForeign:
+		return k, nil
+	default:
+		return KindUnknown, fmt.Errorf("%w: %q", ErrUnknownKind, name)
+	}
+}
+
 // Validator validates Russian Taxpayer Identification Numbers (INN).
 type Validator struct {
 	inn            string
@@ -38,7 +86,7 @@ type Validator struct {
 func NewValidator(inn string, requiredLength int) *Validator {
 	trimmedInn := strings.TrimSpace(inn)
 	if requiredLength == 0 {
-		requiredLength = len(trimmedInn)
+		requiredLength = utf8.RuneCountInString(trimmedInn)
 	}
 	return &Validator{
 		inn:            trimmedInn,
@@ -48,30 +96,30 @@ func NewValidator(inn string, requiredLength int) *Validator {
 
 // Validate checks the correctness of the INN string.
 func (v *Validator) Validate() error {
+	// non-digit characters are checked first, so they are reported with their positions
+	// instead of a length error of the whole value
+	innNumbers, err := parseDigits(v.inn, ErrInnFormat)
+	if err != nil {
+		return err
+	}
+
 	if v.requiredLength != PhysicalLength && v.requiredLength != JuridicalLength {
-		return fmt.Errorf(
-			"%w: valid required lengths are %d or %d, got %d",
-			ErrInnLength, PhysicalLength, JuridicalLength, v.requiredLength,
+		return newLengthError(
+			ErrInnLength,
+			fmt.Sprintf("%d or %d", PhysicalLength, JuridicalLength),
+			v.requiredLength,
+			fmt.Sprintf("valid required lengths are %d or %d, got %d", PhysicalLength, JuridicalLength, v.requiredLength),
 		)
 	}
 
-	innLength := len(v.inn)
+	innLength := len(innNumbers)
 	if innLength != v.requiredLength {
-		return fmt.Errorf("%w: got %d, expected %d", ErrInnLength, innLength, v.requiredLength)
-	}
-
-	innNumbers := make([]int, innLength)
-	for i, r := range v.inn {
-		if !unicode.IsDigit(r) {
-			return fmt.Errorf("%w: not a decimal number '%c'", ErrInnLength, r)
-		}
-
-		digit, err := strconv.Atoi(string(r))
-		if err != nil {
-			return fmt.Errorf("%w: failed to parse digit '%c': %w", ErrInnChecksum, r, err)
-		}
-
-		innNumbers[i] = digit
+		return newLengthError(
+			ErrInnLength,
+			strconv.Itoa(v.requiredLength),
+			innLength,
+			fmt.Sprintf("got %d, expected %d", innLength, v.requiredLength),
+		)
 	}
 
 	if v.requiredLength == PhysicalLength {
@@ -81,6 +129,16 @@ func (v *Validator) Validate() error {
 	return v.validateJuridical(innNumbers)
 }
 
+// ValidateStrict checks the INN like Validate and also rejects tax office codes which do not exist:
+// codes absent in the embedded registry of regions with complete tax office lists.
+func (v *Validator) ValidateStrict() error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	return checkTaxOffice(v.inn[:taxOfficeLength])
+}
+
 // validatePhysical checks the validity of a physical person's INN (12 digits).
 func (v *Validator) validatePhysical(innNumbers []int) error {
 	if n := len(innNumbers); n != PhysicalLength {
@@ -98,16 +156,16 @@ func (v *Validator) validatePhysical(innNumbers []int) error {
 	}
 
 	if part1 != innNumbers[10] {
-		return fmt.Errorf(
-			"%w: invalid physical inn, 11th digit is %d, expected %d",
-			ErrInnChecksum, innNumbers[10], part1,
+		return newChecksumError(
+			ErrInnChecksum, 11, part1, innNumbers[10],
+			fmt.Sprintf("invalid physical inn, 11th digit is %d, expected %d", innNumbers[10], part1),
 		)
 	}
 
 	if part2 != innNumbers[11] {
-		return fmt.Errorf(
-			"%w: invalid physical inn, 12th digit is %d, expected %d",
-			ErrInnChecksum, innNumbers[11], part2,
+		return newChecksumError(
+			ErrInnChecksum, 12, part2, innNumbers[11],
+			fmt.Sprintf("invalid physical inn, 12th digit is %d, expected %d", innNumbers[11], part2),
 		)
 	}
 
@@ -126,26 +184,45 @@ func (v *Validator) validateJuridical(innNumbers []int) error {
 	}
 
 	if controlValue != innNumbers[9] {
-		return fmt.Errorf(
-			"%w: invalid juridical inn, expected %d, got %d",
-			ErrInnChecksum, controlValue, innNumbers[9],
+		return newChecksumError(
+			ErrInnChecksum, 10, controlValue, innNumbers[9],
+			fmt.Sprintf("invalid juridical inn, expected %d, got %d", controlValue, innNumbers[9]),
 		)
 	}
 
+	if strings.HasPrefix(v.inn, foreignPrefix) {
+		return validateKIO(v.inn)
+	}
+
 	return nil
 }
 
+// parseDigits converts a string of ASCII digits to numbers,
+// it returns a format error with the position of the first non-digit character.
+func parseDigits(value string, formatErr error) ([]int, error) {
+	digits := make([]int, 0, len(value))
+	position := 0
+
+	for _, r := range value {
+		position++
+
+		if r < '0' || r > '9' {
+			return nil, newFormatError(formatErr, position, r)
+		}
+
+		digits = append(digits, int(r-'0'))
+	}
+
+	return digits, nil
+}
+
 // calculateControlValue calculates the checksum digit based on weights and INN digits.
 func calculateControlValue(weights []int, innNumbers []int) (int, error) {
 	const checkpointThreshold = 9
 
-	if n, m := len(innNumbers), len(weights); n < m {
-		return 0, fmt.Errorf("inn length %d is less than weights length %d", n, m)
-	}
-
-	sum := 0
-	for i, w := range weights {
-		sum += innNumbers[i] * w
+	sum, err := weightedSum(weights, innNumbers)
+	if err != nil {
+		return 0, err
 	}
 
 	remainder := sum % 11
@@ -155,15 +232,28 @@ func calculateControlValue(weights []int, innNumbers []int) (int, error) {
 	return remainder, nil
 }
 
+// weightedSum returns the sum of digits multiplied by weights, digits after the weights are ignored.
+func weightedSum(weights []int, digits []int) (int, error) {
+	if n, m := len(digits), len(weights); n < m {
+		return 0, fmt.Errorf("digits length %d is less than weights length %d", n, m)
+	}
+
+	sum := 0
+	for i, w := range weights {
+		sum += digits[i] * w
+	}
+	return sum, nil
+}
+
 // FmtResult returns a result string for a given INN.
 func FmtResult(inn string, err error) string {
 	if err != nil {
 		return fmt.Sprintf("INN %s invalid: %v", inn, err)
 	}
 
-	innType := "juridical"
-	if len(inn) == PhysicalLength {
-		innType = "physical"
+	if kind := kindOfValid(strings.TrimSpace(inn)); kind == KindForeign {
+		return fmt.Sprintf("INN %s is valid (foreign organization)", inn)
 	}
-	return fmt.Sprintf("INN %s is valid (%s person)", inn, innType)
+
+	return fmt.Sprintf("INN %s is valid (%s person)", inn, KindOf(inn))
 }
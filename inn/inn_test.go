@@ -98,31 +98,31 @@ func TestValidator_Validate(t *testing.T) {
 			name:           "contains letter",
 			inn:            "77070838A3",
 			requiredLength: 0,
-			wantErr:        ErrInnLength,
+			wantErr:        ErrInnFormat,
 		},
 		{
 			name:           "contains space in middle",
 			inn:            "7707 083893",
 			requiredLength: 0,
-			wantErr:        ErrInnLength,
+			wantErr:        ErrInnFormat,
 		},
 		{
 			name:           "contains dash",
 			inn:            "7707-083893",
 			requiredLength: 0,
-			wantErr:        ErrInnLength,
+			wantErr:        ErrInnFormat,
 		},
 		{
 			name:           "contains special character",
 			inn:            "7707083893!",
 			requiredLength: 0,
-			wantErr:        ErrInnLength,
+			wantErr:        ErrInnFormat,
 		},
 		{
 			name:           "contains unicode digit",
 			inn:            "770708389٣", // Arabic-Indic digit 3
 			requiredLength: 0,
-			wantErr:        ErrInnLength,
+			wantErr:        ErrInnFormat,
 		},
 		{
 			name:           "physical INN wrong 11th digit",
@@ -200,6 +200,142 @@ func TestValidator_Validate(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inn            string
+		requiredLength int
+		want           ValidationError
+	}{
+		{
+			name: "invalid length",
+			inn:  "12345678901",
+			want: ValidationError{Err: ErrInnLength, Kind: ErrorKindLength, Expected: "12 or 10", Actual: "11"},
+		},
+		{
+			name:           "length mismatch",
+			inn:            "7707083893",
+			requiredLength: PhysicalLength,
+			want:           ValidationError{Err: ErrInnLength, Kind: ErrorKindLength, Expected: "12", Actual: "10"},
+		},
+		{
+			name: "letter",
+			inn:  "77070838A3",
+			want: ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 9, Expected: "0-9", Actual: "A"},
+		},
+		{
+			name: "cyrillic letter",
+			inn:  "770708389З",
+			want: ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 10, Expected: "0-9", Actual: "З"},
+		},
+		{
+			name:           "cyrillic letter with required length",
+			inn:            "77070838З3",
+			requiredLength: JuridicalLength,
+			want:           ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 9, Expected: "0-9", Actual: "З"},
+		},
+		{
+			name: "space with wrong length",
+			inn:  "7707 083893",
+			want: ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 5, Expected: "0-9", Actual: " "},
+		},
+		{
+			name: "letters with wrong length",
+			inn:  "abc",
+			want: ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 1, Expected: "0-9", Actual: "a"},
+		},
+		{
+			name:           "letter with invalid required length",
+			inn:            "77070838A3",
+			requiredLength: 11,
+			want:           ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 9, Expected: "0-9", Actual: "A"},
+		},
+		{
+			name: "first character",
+			inn:  "x707083893",
+			want: ValidationError{Err: ErrInnFormat, Kind: ErrorKindFormat, Position: 1, Expected: "0-9", Actual: "x"},
+		},
+		{
+			name: "juridical checksum",
+			inn:  "7707083892",
+			want: ValidationError{Err: ErrInnChecksum, Kind: ErrorKindChecksum, Position: 10, Expected: "3", Actual: "2"},
+		},
+		{
+			name: "physical 11th digit",
+			inn:  "500100732249",
+			want: ValidationError{Err: ErrInnChecksum, Kind: ErrorKindChecksum, Position: 11, Expected: "5", Actual: "4"},
+		},
+		{
+			name: "physical 12th digit",
+			inn:  "500100732250",
+			want: ValidationError{Err: ErrInnChecksum, Kind: ErrorKindChecksum, Position: 12, Expected: "9", Actual: "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewValidator(tt.inn, tt.requiredLength).Validate()
+
+			got, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+
+			if got.Err != tt.want.Err || got.Kind != tt.want.Kind || got.Position != tt.want.Position ||
+				got.Expected != tt.want.Expected || got.Actual != tt.want.Actual {
+				t.Errorf("Validate() error = %+v, want %+v", *got, tt.want)
+			}
+
+			if !errors.Is(err, tt.want.Err) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.want.Err)
+			}
+
+			if msg := err.Error(); !strings.HasPrefix(msg, tt.want.Err.Error()) {
+				t.Errorf("Error() = %q, want prefix %q", msg, tt.want.Err.Error())
+			}
+		})
+	}
+
+	t.Run("valid INN", func(t *testing.T) {
+		t.Parallel()
+
+		if _, ok := AsValidationError(NewValidator("7707083893", 0).Validate()); ok {
+			t.Error("AsValidationError() = true for valid INN")
+		}
+	})
+}
+
+func TestErrorKindOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorKind
+	}{
+		{name: "nil", err: nil, want: ""},
+		{name: "length", err: NewValidator("123", 0).Validate(), want: ErrorKindLength},
+		{name: "format", err: NewValidator("77070838A3", 0).Validate(), want: ErrorKindFormat},
+		{name: "checksum", err: NewValidator("7707083892", 0).Validate(), want: ErrorKindChecksum},
+		{name: "tax office", err: NewValidator("7799000012", 0).ValidateStrict(), want: ErrorKindTaxOffice},
+		{name: "other error", err: errors.New("failed"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ErrorKindOf(tt.err); got != tt.want {
+				t.Errorf("ErrorKindOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestFmtResult(t *testing.T) {
 	t.Parallel()
 
@@ -221,6 +357,12 @@ func TestFmtResult(t *testing.T) {
 			err:            nil,
 			wantSubstrings: []string{"7707083893", "valid", "juridical"},
 		},
+		{
+			name:           "valid foreign organization INN",
+			inn:            "9909123454",
+			err:            nil,
+			wantSubstrings: []string{"9909123454", "valid", "foreign organization"},
+		},
 		{
 			name:           "invalid INN with error",
 			inn:            "123",
@@ -281,3 +423,61 @@ func BenchmarkGenerateJuridicalINN(b *testing.B) {
 		_, _ = GenerateJuridicalINN()
 	}
 }
+
+func TestKindOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		inn  string
+		want Kind
+	}{
+		{name: "physical", inn: "500100732259", want: KindPhysical},
+		{name: "juridical", inn: "7707083893", want: KindJuridical},
+		{name: "juridical with spaces", inn: " 7707083893 ", want: KindJuridical},
+		{name: "juridical with cyrillic letter", inn: "770708389З", want: KindJuridical},
+		{name: "unknown length", inn: "123", want: KindUnknown},
+		{name: "empty", inn: "", want: KindUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := KindOf(tt.inn); got != tt.want {
+				t.Errorf("KindOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    Kind
+		wantErr error
+	}{
+		{name: "physical", value: "physical", want: KindPhysical},
+		{name: "juridical upper case", value: "Juridical", want: KindJuridical},
+		{name: "foreign", value: "foreign", want: KindForeign},
+		{name: "empty", value: "", wantErr: ErrUnknownKind},
+		{name: "unknown", value: "legal", wantErr: ErrUnknownKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseKind(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseKind() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
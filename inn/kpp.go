@@ -0,0 +1,188 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KPPLength is the valid length for a tax registration reason code (KPP).
+	KPPLength = 9
+
+	// KPPReasonHeadOffice is a KPP reason code of an organization registration at its location.
+	KPPReasonHeadOffice = "01"
+	// KPPReasonSubdivision is a KPP reason code of a separate subdivision registration.
+	KPPReasonSubdivision = "02"
+	// KPPReasonBranch is a KPP reason code of a branch registration.
+	KPPReasonBranch = "43"
+	// KPPReasonLargestTaxpayer is a KPP reason code of the largest taxpayer registration.
+	KPPReasonLargestTaxpayer = "50"
+
+	kppReasonStart = 4
+	kppReasonEnd   = 6
+	maxKPPSerial   = 999
+)
+
+var (
+	// kppReasons contains names of common KPP reason codes.
+	kppReasons = map[string]string{ //nolint:gochecknoglobals
+		"01": "head office",
+		"02": "separate subdivision",
+		"03": "separate subdivision",
+		"04": "separate subdivision",
+		"05": "separate subdivision",
+		"06": "real estate location",
+		"07": "real estate location",
+		"08": "real estate location",
+		"31": "separate subdivision",
+		"32": "separate subdivision",
+		"43": "branch",
+		"50": "largest taxpayer",
+	}
+
+	// ErrKppLength is an error indicating an invalid KPP length.
+	ErrKppLength = errors.New("invalid KPP length")
+	// ErrKppFormat is an error indicating an invalid KPP character.
+	ErrKppFormat = errors.New("invalid KPP format")
+	// ErrKppGeneration is an error indicating an error during KPP generation.
+	ErrKppGeneration = errors.New("failed to generate KPP")
+)
+
+// KPPInfo is a structured information decoded from a valid KPP.
+type KPPInfo struct {
+	KPP           string `json:"kpp"`
+	TaxOffice     string `json:"tax_office"`
+	TaxOfficeName string `json:"tax_office_name,omitempty"`
+	Reason        string `json:"reason"`
+	ReasonName    string `json:"reason_name,omitempty"`
+	Serial        string `json:"serial"`
+}
+
+// ValidateKPP checks the correctness of KPP: 4 digits of the tax office code (SOUN),
+// 2 digits or capital Latin letters of the reason code and 3 digits of the serial number.
+func ValidateKPP(kpp string) error {
+	return validateKPP(strings.TrimSpace(kpp))
+}
+
+// validateKPP checks the length and characters of KPP.
+func validateKPP(value string) error {
+	if n := len(value); n != KPPLength {
+		return newLengthError(ErrKppLength, strconv.Itoa(KPPLength), n, fmt.Sprintf("got %d, expected %d", n, KPPLength))
+	}
+
+	position := 0
+	for _, r := range value {
+		position++
+
+		if isReasonPosition(position) && r >= 'A' && r <= 'Z' {
+			continue
+		}
+
+		if r < '0' || r > '9' {
+			err := newFormatError(ErrKppFormat, position, r)
+			if isReasonPosition(position) {
+				err.Expected = "0-9, A-Z"
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isReasonPosition returns true if the 1-based KPP position belongs to the reason code.
+func isReasonPosition(position int) bool {
+	return position > kppReasonStart && position <= kppReasonEnd
+}
+
+// ParseKPP validates KPP and decodes it into a structured information.
+func ParseKPP(kpp string) (*KPPInfo, error) {
+	value := strings.TrimSpace(kpp)
+	if err := validateKPP(value); err != nil {
+		return nil, err
+	}
+
+	info := &KPPInfo{
+		KPP:       value,
+		TaxOffice: value[:taxOfficeLength],
+		Reason:    value[kppReasonStart:kppReasonEnd],
+		Serial:    value[kppReasonEnd:],
+	}
+
+	info.TaxOfficeName, _ = TaxOfficeName(info.TaxOffice)
+	info.ReasonName, _ = KPPReasonName(info.Reason)
+
+	return info, nil
+}
+
+// KPPReasonName returns a name of the common KPP reason code.
+func KPPReasonName(reason string) (string, bool) {
+	name, ok := kppReasons[reason]
+	return name, ok
+}
+
+// KPP generates a valid KPP with the reason code, the empty reason means KPPReasonHeadOffice.
+// It uses the generator's region and tax office for the tax office code.
+func (g *Generator) KPP(reason string) (string, error) {
+	region, office := g.region, g.office
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, code := range []*int{&region, &office} {
+		if *code == 0 {
+			n, err := randomInt(g.random(), maxCode)
+			if err != nil {
+				return "", errors.Join(ErrKppGeneration, err)
+			}
+			*code = n + 1
+		}
+	}
+
+	return g.kpp(fmt.Sprintf("%02d%02d", region, office), reason)
+}
+
+// KPPForINN generates a valid KPP with the reason code for the juridical INN,
+// the KPP tax office code is the same as the INN one. The empty reason means KPPReasonHeadOffice.
+func (g *Generator) KPPForINN(inn, reason string) (string, error) {
+	validator := NewValidator(inn, JuridicalLength)
+	if err := validator.Validate(); err != nil {
+		return "", errors.Join(ErrKppGeneration, err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.kpp(validator.inn[:taxOfficeLength], reason)
+}
+
+// kpp builds KPP from the tax office code, reason code and a serial number,
+// the serial number of the head office is 001. The caller must hold the generator lock.
+func (g *Generator) kpp(office, reason string) (string, error) {
+	if reason == "" {
+		reason = KPPReasonHeadOffice
+	}
+
+	serial := 1
+	if reason != KPPReasonHeadOffice {
+		n, err := randomInt(g.random(), maxKPPSerial)
+		if err != nil {
+			return "", errors.Join(ErrKppGeneration, err)
+		}
+		serial = n + 1
+	}
+
+	kpp := fmt.Sprintf("%s%s%03d", office, reason, serial)
+	if err := validateKPP(kpp); err != nil {
+		return "", errors.Join(ErrKppGeneration, err)
+	}
+
+	return kpp, nil
+}
+
+// GenerateKPP generates a valid head office KPP for the juridical INN.
+func GenerateKPP(inn string) (string, error) {
+	return defaultGenerator.KPPForINN(inn, KPPReasonHeadOffice)
+}
@@ -0,0 +1,137 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateKPP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		kpp          string
+		wantErr      error
+		wantPos      int
+		wantExpected string
+	}{
+		{name: "head office", kpp: "770701001"},
+		{name: "with spaces", kpp: " 773601001 "},
+		{name: "letter reason", kpp: "7707AB001"},
+		{name: "short", kpp: "77070100", wantErr: ErrKppLength, wantExpected: "9"},
+		{name: "letter in tax office", kpp: "77A701001", wantErr: ErrKppFormat, wantPos: 3, wantExpected: "0-9"},
+		{name: "lowercase reason", kpp: "7707a1001", wantErr: ErrKppFormat, wantPos: 5, wantExpected: "0-9, A-Z"},
+		{name: "letter in serial", kpp: "77070100A", wantErr: ErrKppFormat, wantPos: 9, wantExpected: "0-9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateKPP(tt.kpp)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateKPP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil {
+				return
+			}
+
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("error %v is not a ValidationError", err)
+			}
+
+			if ve.Position != tt.wantPos || ve.Expected != tt.wantExpected {
+				t.Errorf("ValidationError = %+v, want position %d expected %q", ve, tt.wantPos, tt.wantExpected)
+			}
+		})
+	}
+}
+
+func TestParseKPP(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseKPP("770743001")
+	if err != nil {
+		t.Fatalf("ParseKPP() error = %v", err)
+	}
+
+	want := KPPInfo{
+		KPP:           "770743001",
+		TaxOffice:     "7707",
+		TaxOfficeName: "ИФНС России № 7 по г. Москве",
+		Reason:        KPPReasonBranch,
+		ReasonName:    "branch",
+		Serial:        "001",
+	}
+
+	if *got != want {
+		t.Errorf("ParseKPP() = %+v, want %+v", got, want)
+	}
+
+	if _, err = ParseKPP("7707430010"); !errors.Is(err, ErrKppLength) {
+		t.Errorf("ParseKPP() error = %v, want %v", err, ErrKppLength)
+	}
+}
+
+func TestGenerator_KPP(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(1), WithRegion(78), WithTaxOffice(5))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	for _, reason := range []string{"", KPPReasonHeadOffice, KPPReasonSubdivision, KPPReasonBranch} {
+		kpp, err := g.KPP(reason)
+		if err != nil {
+			t.Fatalf("KPP(%q) error = %v", reason, err)
+		}
+
+		if err = ValidateKPP(kpp); err != nil {
+			t.Errorf("ValidateKPP(%s) error = %v", kpp, err)
+		}
+
+		if !strings.HasPrefix(kpp, "7805") {
+			t.Errorf("KPP(%q) = %s, want prefix 7805", reason, kpp)
+		}
+
+		if reason == "" {
+			reason = KPPReasonHeadOffice
+		}
+
+		if r := kpp[4:6]; r != reason {
+			t.Errorf("KPP() reason = %s, want %s", r, reason)
+		}
+	}
+
+	if _, err = g.KPP("0a"); !errors.Is(err, ErrKppGeneration) || !errors.Is(err, ErrKppFormat) {
+		t.Errorf("KPP() error = %v, want %v", err, ErrKppFormat)
+	}
+}
+
+func TestGenerateKPP(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		value, err := GenerateJuridicalINN()
+		if err != nil {
+			t.Fatalf("GenerateJuridicalINN() error = %v", err)
+		}
+
+		kpp, err := GenerateKPP(value)
+		if err != nil {
+			t.Fatalf("GenerateKPP() error = %v", err)
+		}
+
+		if want := value[:4] + KPPReasonHeadOffice + "001"; kpp != want {
+			t.Errorf("GenerateKPP(%s) = %s, want %s", value, kpp, want)
+		}
+	}
+
+	if _, err := GenerateKPP("500100732259"); !errors.Is(err, ErrInnLength) {
+		t.Errorf("GenerateKPP() error = %v, want %v", err, ErrInnLength)
+	}
+}
@@ -0,0 +1,72 @@
+package inn
+
+import "strings"
+
+// LegalForm is an organizational and legal form of a legal entity.
+type LegalForm struct {
+	Short string `json:"short"`
+	Full  string `json:"full"`
+}
+
+var (
+	// legalForms are common legal forms of commercial organizations.
+	legalForms = []LegalForm{ //nolint:gochecknoglobals
+		{Short: "ООО", Full: "Общество с ограниченной ответственностью"},
+		{Short: "АО", Full: "Акционерное общество"},
+		{Short: "ПАО", Full: "Публичное акционерное общество"},
+		{Short: "НАО", Full: "Непубличное акционерное общество"},
+	}
+
+	// companyNamePrefixes and companyNameSuffixes are parts of generated company names.
+	companyNamePrefixes = []string{ //nolint:gochecknoglobals
+		"Альфа", "Вектор", "Гранит", "Север", "Юг", "Восток", "Техно", "Строй", "Агро", "Энерго",
+		"Мега", "Прима", "Орион", "Сибирь", "Волга", "Урал", "Меридиан", "Атлант", "Спектр", "Феникс",
+		"Кристалл", "Горизонт", "Престиж", "Стандарт", "Эталон", "Импульс", "Пром", "Нефте", "Лес", "Инфо",
+	}
+	companyNameSuffixes = []string{ //nolint:gochecknoglobals
+		"Строй", "Торг", "Сервис", "Инвест", "Логистик", "Трейд", "Снаб", "Пром", "Маркет", "Групп",
+		"Ресурс", "Систем", "Технологии", "Холдинг", "Консалт", "Монтаж", "Проект", "Транс", "Капитал", "Софт",
+	}
+)
+
+var (
+	// maleFirstNames and femaleFirstNames are first names of generated persons.
+	maleFirstNames = []string{ //nolint:gochecknoglobals
+		"Александр", "Алексей", "Андрей", "Антон", "Артём", "Борис", "Вадим", "Валерий", "Василий", "Виктор",
+		"Владимир", "Дмитрий", "Евгений", "Егор", "Иван", "Игорь", "Илья", "Кирилл", "Константин", "Максим",
+		"Михаил", "Николай", "Олег", "Павел", "Роман", "Сергей", "Станислав", "Степан", "Юрий", "Ярослав",
+	}
+	femaleFirstNames = []string{ //nolint:gochecknoglobals
+		"Александра", "Алина", "Анастасия", "Анна", "Валентина", "Вера", "Виктория", "Галина", "Дарья", "Евгения",
+		"Екатерина", "Елена", "Ирина", "Ксения", "Лариса", "Любовь", "Людмила", "Марина", "Мария", "Наталья",
+		"Надежда", "Нина", "Оксана", "Ольга", "Полина", "Светлана", "Софья", "Татьяна", "Юлия", "Яна",
+	}
+
+	// patronymics are male and female patronymics derived from the same father's name.
+	patronymics = [][2]string{ //nolint:gochecknoglobals
+		{"Александрович", "Александровна"}, {"Алексеевич", "Алексеевна"}, {"Андреевич", "Андреевна"},
+		{"Борисович", "Борисовна"}, {"Васильевич", "Васильевна"}, {"Викторович", "Викторовна"},
+		{"Владимирович", "Владимировна"}, {"Дмитриевич", "Дмитриевна"}, {"Евгеньевич", "Евгеньевна"},
+		{"Иванович", "Ивановна"}, {"Игоревич", "Игоревна"}, {"Ильич", "Ильинична"},
+		{"Константинович", "Константиновна"}, {"Михайлович", "Михайловна"}, {"Николаевич", "Николаевна"},
+		{"Олегович", "Олеговна"}, {"Павлович", "Павловна"}, {"Петрович", "Петровна"},
+		{"Романович", "Романовна"}, {"Сергеевич", "Сергеевна"}, {"Юрьевич", "Юрьевна"},
+	}
+
+	// lastNames are male last names, female ones are derived by feminineLastName.
+	lastNames = []string{ //nolint:gochecknoglobals
+		"Иванов", "Смирнов", "Кузнецов", "Попов", "Васильев", "Петров", "Соколов", "Михайлов", "Новиков", "Фёдоров",
+		"Морозов", "Волков", "Алексеев", "Лебедев", "Семёнов", "Егоров", "Павлов", "Козлов", "Степанов", "Николаев",
+		"Орлов", "Андреев", "Макаров", "Никитин", "Захаров", "Зайцев", "Соловьёв", "Борисов", "Яковлев", "Григорьев",
+		"Романов", "Воробьёв", "Сергеев", "Фомин", "Ильин", "Калинин", "Голубев", "Виноградов", "Богданов", "Тарасов",
+		"Белов", "Комаров", "Киселёв", "Ковалевский", "Покровский", "Вишневский", "Успенский", "Троицкий", "Лосев", "Гусев",
+	}
+)
+
+// feminineLastName returns a female form of the male last name ending with -ов, -ев, -ин or -ский.
+func feminineLastName(name string) string {
+	if base, ok := strings.CutSuffix(name, "ий"); ok {
+		return base + "ая"
+	}
+	return name + "а"
+}
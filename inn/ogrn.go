@@ -0,0 +1,164 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// OGRNLength is the valid length for a legal entity OGRN.
+	OGRNLength = 13
+	// OGRNIPLength is the valid length for an individual entrepreneur OGRNIP.
+	OGRNIPLength = 15
+
+	// MinRegistrationYear is the first year of OGRN and OGRNIP registration.
+	MinRegistrationYear = 2002
+
+	ogrnModulus   = 11
+	ogrnipModulus = 13
+)
+
+var (
+	// ErrOgrnLength is an error indicating an invalid OGRN or OGRNIP length.
+	ErrOgrnLength = errors.New("invalid OGRN length")
+	// ErrOgrnFormat is an error indicating a non-digit character or an invalid sign of OGRN or OGRNIP.
+	ErrOgrnFormat = errors.New("invalid OGRN format")
+	// ErrOgrnChecksum is an error indicating an invalid OGRN or OGRNIP checksum.
+	ErrOgrnChecksum = errors.New("invalid OGRN checksum")
+	// ErrOgrnGeneration is an error indicating an error during OGRN or OGRNIP generation.
+	ErrOgrnGeneration = errors.New("failed to generate OGRN")
+)
+
+// ValidateOGRN checks the correctness of a 13-digit primary state registration number (OGRN)
+// of a legal entity, its first digit (sign) should be 1 or 5.
+func ValidateOGRN(ogrn string) error {
+	return validateOGRN(strings.TrimSpace(ogrn), OGRNLength, ogrnModulus, "15")
+}
+
+// ValidateOGRNIP checks the correctness of a 15-digit primary state registration number (OGRNIP)
+// of an individual entrepreneur, its first digit (sign) should be 3.
+func ValidateOGRNIP(ogrnip string) error {
+	return validateOGRN(strings.TrimSpace(ogrnip), OGRNIPLength, ogrnipModulus, "3")
+}
+
+// validateOGRN checks the length, format, sign and checksum of OGRN or OGRNIP.
+func validateOGRN(value string, length int, modulus uint64, signs string) error {
+	if n := len(value); n != length {
+		return newLengthError(ErrOgrnLength, strconv.Itoa(length), n, fmt.Sprintf("got %d, expected %d", n, length))
+	}
+
+	digits, err := parseDigits(value, ErrOgrnFormat)
+	if err != nil {
+		return err
+	}
+
+	if sign := value[:1]; !strings.Contains(signs, sign) {
+		return &ValidationError{
+			Err:      ErrOgrnFormat,
+			Kind:     ErrorKindFormat,
+			Position: 1,
+			Expected: strings.Join(strings.Split(signs, ""), " or "),
+			Actual:   sign,
+			Message:  fmt.Sprintf("invalid sign %s", sign),
+		}
+	}
+
+	controlValue := ogrnControlValue(digits[:length-1], modulus)
+	if actual := digits[length-1]; controlValue != actual {
+		return newChecksumError(
+			ErrOgrnChecksum, length, controlValue, actual,
+			fmt.Sprintf("expected %d, got %d", controlValue, actual),
+		)
+	}
+
+	return nil
+}
+
+// ogrnControlValue calculates the check digit as the last digit of the number remainder by the modulus.
+func ogrnControlValue(digits []int, modulus uint64) int {
+	var number uint64
+	for _, d := range digits {
+		number = number*10 + uint64(d) //nolint:gosec // d is a decimal digit
+	}
+	return int(number % modulus % 10) //nolint:gosec // the value is a decimal digit
+}
+
+// OGRN generates a valid 13-digit OGRN of a legal entity,
+// it uses the generator's registration year, region and tax office.
+func (g *Generator) OGRN() (string, error) {
+	// sign (1) + year (2) + region (2) + tax office (2) + record number (5) + check digit (1)
+	digits, err := g.registrationDigits(OGRNLength)
+	if err != nil {
+		return "", errors.Join(ErrOgrnGeneration, err)
+	}
+
+	digits[0] = 1
+	if g.office != 0 {
+		digits[5], digits[6] = g.office/10, g.office%10
+	}
+
+	digits[OGRNLength-1] = ogrnControlValue(digits[:OGRNLength-1], ogrnModulus)
+	return digitsToString(digits), nil
+}
+
+// OGRNIP generates a valid 15-digit OGRNIP of an individual entrepreneur,
+// it uses the generator's registration year and region.
+func (g *Generator) OGRNIP() (string, error) {
+	// sign (1) + year (2) + region (2) + record number (9) + check digit (1)
+	digits, err := g.registrationDigits(OGRNIPLength)
+	if err != nil {
+		return "", errors.Join(ErrOgrnGeneration, err)
+	}
+
+	digits[0] = 3
+	digits[OGRNIPLength-1] = ogrnControlValue(digits[:OGRNIPLength-1], ogrnipModulus)
+	return digitsToString(digits), nil
+}
+
+// registrationDigits returns random digits with the registration year at positions 2-3
+// and the region code at positions 4-5, random values are used for not configured ones.
+func (g *Generator) registrationDigits(length int) ([]int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	digits, err := randomDigits(g.random(), length)
+	if err != nil {
+		return nil, err
+	}
+
+	year := g.year
+	if year == 0 {
+		n, yearErr := randomInt(g.random(), g.maxRandomYear()-MinRegistrationYear+1)
+		if yearErr != nil {
+			return nil, yearErr
+		}
+		year = MinRegistrationYear + n
+	}
+
+	region := g.region
+	if region == 0 {
+		n, regionErr := randomInt(g.random(), maxCode)
+		if regionErr != nil {
+			return nil, regionErr
+		}
+		region = n + 1
+	}
+
+	yy := year % 100 //nolint:mnd
+	digits[1], digits[2] = yy/10, yy%10
+	digits[3], digits[4] = region/10, region%10
+
+	return digits, nil
+}
+
+// GenerateOGRN generates a valid 13-digit OGRN of a legal entity.
+func GenerateOGRN() (string, error) {
+	return defaultGenerator.OGRN()
+}
+
+// GenerateOGRNIP generates a valid 15-digit OGRNIP of an individual entrepreneur.
+func GenerateOGRNIP() (string, error) {
+	return defaultGenerator.OGRNIP()
+}
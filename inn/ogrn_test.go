@@ -0,0 +1,152 @@
+package inn
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestValidateOGRN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		ogrn     string
+		ip       bool
+		wantErr  error
+		wantKind ErrorKind
+		wantPos  int
+	}{
+		{name: "valid OGRN", ogrn: "1027700132195"},
+		{name: "valid OGRN with spaces", ogrn: " 1027700132195 "},
+		{name: "valid OGRNIP", ogrn: "304500116000157", ip: true},
+		{name: "OGRN length", ogrn: "102770013219", wantErr: ErrOgrnLength, wantKind: ErrorKindLength},
+		{name: "OGRNIP length", ogrn: "1027700132195", ip: true, wantErr: ErrOgrnLength, wantKind: ErrorKindLength},
+		{name: "OGRN format", ogrn: "10277001321X5", wantErr: ErrOgrnFormat, wantKind: ErrorKindFormat, wantPos: 12},
+		{name: "OGRN sign", ogrn: "3027700132195", wantErr: ErrOgrnFormat, wantKind: ErrorKindFormat, wantPos: 1},
+		{name: "OGRNIP sign", ogrn: "104500116000157", ip: true, wantErr: ErrOgrnFormat, wantKind: ErrorKindFormat, wantPos: 1},
+		{name: "OGRN checksum", ogrn: "1027700132194", wantErr: ErrOgrnChecksum, wantKind: ErrorKindChecksum, wantPos: 13},
+		{
+			name: "OGRNIP checksum", ogrn: "304500116000150", ip: true,
+			wantErr: ErrOgrnChecksum, wantKind: ErrorKindChecksum, wantPos: 15,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validate := ValidateOGRN
+			if tt.ip {
+				validate = ValidateOGRNIP
+			}
+
+			err := validate(tt.ogrn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil {
+				return
+			}
+
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("error %v is not a ValidationError", err)
+			}
+
+			if ve.Kind != tt.wantKind || ve.Position != tt.wantPos {
+				t.Errorf("ValidationError = %+v, want kind %q at position %d", ve, tt.wantKind, tt.wantPos)
+			}
+		})
+	}
+}
+
+func TestGenerator_OGRN(t *testing.T) {
+	t.Parallel()
+
+	g, err := NewGenerator(WithSeed(42), WithRegion(77), WithTaxOffice(46), WithRegistrationYear(2012))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	for range 100 {
+		ogrn, err := g.OGRN()
+		if err != nil {
+			t.Fatalf("OGRN() error = %v", err)
+		}
+
+		if err = ValidateOGRN(ogrn); err != nil {
+			t.Errorf("ValidateOGRN(%s) error = %v", ogrn, err)
+		}
+
+		if prefix := ogrn[:7]; prefix != "1127746" {
+			t.Errorf("OGRN() = %s, want prefix 1127746", ogrn)
+		}
+
+		ogrnip, err := g.OGRNIP()
+		if err != nil {
+			t.Fatalf("OGRNIP() error = %v", err)
+		}
+
+		if err = ValidateOGRNIP(ogrnip); err != nil {
+			t.Errorf("ValidateOGRNIP(%s) error = %v", ogrnip, err)
+		}
+
+		if prefix := ogrnip[:5]; prefix != "31277" {
+			t.Errorf("OGRNIP() = %s, want prefix 31277", ogrnip)
+		}
+	}
+}
+
+func TestGenerateOGRN(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		ogrn, err := GenerateOGRN()
+		if err != nil {
+			t.Fatalf("GenerateOGRN() error = %v", err)
+		}
+
+		if err = ValidateOGRN(ogrn); err != nil {
+			t.Errorf("ValidateOGRN(%s) error = %v", ogrn, err)
+		}
+
+		ogrnip, err := GenerateOGRNIP()
+		if err != nil {
+			t.Fatalf("GenerateOGRNIP() error = %v", err)
+		}
+
+		if err = ValidateOGRNIP(ogrnip); err != nil {
+			t.Errorf("ValidateOGRNIP(%s) error = %v", ogrnip, err)
+		}
+
+		year, err := strconv.Atoi(ogrnip[1:3])
+		if err != nil || year < MinRegistrationYear%100 || year > currentYear()%100 {
+			t.Errorf("GenerateOGRNIP() = %s, unexpected registration year", ogrnip)
+		}
+
+		if region := ogrn[3:5]; region == "00" {
+			t.Errorf("GenerateOGRN() = %s, unexpected region", ogrn)
+		}
+	}
+}
+
+func TestWithRegistrationYear(t *testing.T) {
+	t.Parallel()
+
+	for _, year := range []int{MinRegistrationYear - 1, currentYear() + 1} {
+		if _, err := NewGenerator(WithRegistrationYear(year)); !errors.Is(err, ErrGeneratorOption) {
+			t.Errorf("NewGenerator(WithRegistrationYear(%d)) error = %v, want %v", year, err, ErrGeneratorOption)
+		}
+	}
+
+	g, err := NewGenerator(WithRegistrationYear(MinRegistrationYear))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if y := g.RegistrationYear(); y != MinRegistrationYear {
+		t.Errorf("RegistrationYear() = %d, want %d", y, MinRegistrationYear)
+	}
+}
@@ -0,0 +1,121 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// OKPOLength is the valid length for OKPO of a legal entity.
+	OKPOLength = 8
+	// OKPOIPLength is the valid length for OKPO of an individual entrepreneur.
+	OKPOIPLength = 10
+)
+
+var (
+	// weightsOKPO1 and weightsOKPO2 are weights of the first and second OKPO checksum passes,
+	// they are cycles of 1-10 starting from 1 and 3, respectively.
+	weightsOKPO1 = []int{1, 2, 3, 4, 5, 6, 7, 8, 9}  //nolint:gochecknoglobals
+	weightsOKPO2 = []int{3, 4, 5, 6, 7, 8, 9, 10, 1} //nolint:gochecknoglobals
+
+	// ErrOkpoLength is an error indicating an invalid OKPO length.
+	ErrOkpoLength = errors.New("invalid OKPO length")
+	// ErrOkpoFormat is an error indicating a non-digit character in OKPO.
+	ErrOkpoFormat = errors.New("invalid OKPO format")
+	// ErrOkpoChecksum is an error indicating an invalid OKPO checksum.
+	ErrOkpoChecksum = errors.New("invalid OKPO checksum")
+	// ErrOkpoGeneration is an error indicating an error during OKPO generation.
+	ErrOkpoGeneration = errors.New("failed to generate OKPO")
+)
+
+// ValidateOKPO checks the correctness of OKPO, it is 8 digits for legal entities
+// and 10 digits for individual entrepreneurs.
+func ValidateOKPO(okpo string) error {
+	value := strings.TrimSpace(okpo)
+
+	n := len(value)
+	if n != OKPOLength && n != OKPOIPLength {
+		return newLengthError(
+			ErrOkpoLength, fmt.Sprintf("%d or %d", OKPOLength, OKPOIPLength), n,
+			fmt.Sprintf("got %d, expected %d or %d", n, OKPOLength, OKPOIPLength),
+		)
+	}
+
+	digits, err := parseDigits(value, ErrOkpoFormat)
+	if err != nil {
+		return err
+	}
+
+	controlValue, err := okpoControlValue(digits[:n-1])
+	if err != nil {
+		return err
+	}
+
+	if actual := digits[n-1]; controlValue != actual {
+		return newChecksumError(
+			ErrOkpoChecksum, n, controlValue, actual,
+			fmt.Sprintf("expected %d, got %d", controlValue, actual),
+		)
+	}
+
+	return nil
+}
+
+// okpoControlValue calculates the OKPO check digit: the weighted sum remainder by 11
+// with weights starting from 1, if it is 10 then with weights starting from 3, if it is 10 again then 0.
+func okpoControlValue(digits []int) (int, error) {
+	const threshold = 10
+
+	for _, weights := range [][]int{weightsOKPO1, weightsOKPO2} {
+		sum, err := weightedSum(weights[:len(digits)], digits)
+		if err != nil {
+			return 0, err
+		}
+
+		if remainder := sum % 11; remainder < threshold {
+			return remainder, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// OKPO generates a valid 8-digit OKPO of a legal entity.
+func (g *Generator) OKPO() (string, error) {
+	return g.okpo(OKPOLength)
+}
+
+// OKPOIP generates a valid 10-digit OKPO of an individual entrepreneur.
+func (g *Generator) OKPOIP() (string, error) {
+	return g.okpo(OKPOIPLength)
+}
+
+// okpo generates a valid OKPO of the given length.
+func (g *Generator) okpo(length int) (string, error) {
+	g.mu.Lock()
+	digits, err := randomDigits(g.random(), length)
+	g.mu.Unlock()
+
+	if err != nil {
+		return "", errors.Join(ErrOkpoGeneration, err)
+	}
+
+	d, err := okpoControlValue(digits[:length-1])
+	if err != nil {
+		return "", errors.Join(ErrOkpoGeneration, err)
+	}
+
+	digits[length-1] = d
+	return digitsToString(digits), nil
+}
+
+// GenerateOKPO generates a valid 8-digit OKPO of a legal entity.
+func GenerateOKPO() (string, error) {
+	return defaultGenerator.OKPO()
+}
+
+// GenerateOKPOIP generates a valid 10-digit OKPO of an individual entrepreneur.
+func GenerateOKPOIP() (string, error) {
+	return defaultGenerator.OKPOIP()
+}
@@ -0,0 +1,68 @@
+package inn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateOKPO(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		okpo    string
+		wantErr error
+		wantPos int
+	}{
+		{name: "legal entity", okpo: "00032537"},
+		{name: "legal entity with spaces", okpo: " 00332245 "},
+		{name: "legal entity second pass", okpo: "79287751"},
+		{name: "legal entity zero", okpo: "07407220"},
+		{name: "entrepreneur", okpo: "3681930368"},
+		{name: "entrepreneur second pass", okpo: "5807302150"},
+		{name: "length", okpo: "000325370", wantErr: ErrOkpoLength},
+		{name: "format", okpo: "0003X537", wantErr: ErrOkpoFormat, wantPos: 5},
+		{name: "checksum", okpo: "00032536", wantErr: ErrOkpoChecksum, wantPos: 8},
+		{name: "second pass checksum", okpo: "79287750", wantErr: ErrOkpoChecksum, wantPos: 8},
+		{name: "entrepreneur checksum", okpo: "3681930369", wantErr: ErrOkpoChecksum, wantPos: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateOKPO(tt.okpo)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateOKPO() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if ve, ok := AsValidationError(err); ok && ve.Position != tt.wantPos {
+				t.Errorf("ValidationError.Position = %d, want %d", ve.Position, tt.wantPos)
+			}
+		})
+	}
+}
+
+func TestGenerateOKPO(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		okpo, err := GenerateOKPO()
+		if err != nil {
+			t.Fatalf("GenerateOKPO() error = %v", err)
+		}
+
+		if err = ValidateOKPO(okpo); err != nil || len(okpo) != OKPOLength {
+			t.Errorf("GenerateOKPO() = %s, error = %v", okpo, err)
+		}
+
+		okpo, err = GenerateOKPOIP()
+		if err != nil {
+			t.Fatalf("GenerateOKPOIP() error = %v", err)
+		}
+
+		if err = ValidateOKPO(okpo); err != nil || len(okpo) != OKPOIPLength {
+			t.Errorf("GenerateOKPOIP() = %s, error = %v", okpo, err)
+		}
+	}
+}
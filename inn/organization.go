@@ -0,0 +1,143 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrOrganizationGeneration is an error indicating an error during organization generation.
+var ErrOrganizationGeneration = errors.New("failed to generate organization")
+
+// Organization is a consistent set of legal entity requisites: INN, KPP and OGRN have the same region
+// and tax office, KPP is the head office one. The address is in the same region, it is nil
+// if the address dataset has no cities in the region.
+type Organization struct {
+	INN       string    `json:"inn"`
+	KPP       string    `json:"kpp"`
+	OGRN      string    `json:"ogrn"`
+	OKPO      string    `json:"okpo"`
+	LegalForm LegalForm `json:"legal_form"`
+	Name      string    `json:"name"`
+	FullName  string    `json:"full_name"`
+	Address   *Address  `json:"address,omitempty"`
+}
+
+// Organization generates a consistent legal entity with a plausible Russian company name.
+// It uses the generator's region, tax office and registration year, random registry codes are used
+// for not configured region and tax office.
+func (g *Generator) Organization() (*Organization, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	region, office, err := g.taxOfficeCodes()
+	if err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	form, name, err := g.companyName()
+	if err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	child := g.childFor(KindJuridical, region, office)
+	org := &Organization{
+		LegalForm: form,
+		Name:      fmt.Sprintf("%s «%s»", form.Short, name),
+		FullName:  fmt.Sprintf("%s «%s»", form.Full, name),
+	}
+
+	if org.INN, err = child.Juridical(); err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	if org.KPP, err = child.KPPForINN(org.INN, KPPReasonHeadOffice); err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	if org.OGRN, err = child.OGRN(); err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	if org.OKPO, err = child.OKPO(); err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	if org.Address, err = g.regionAddress(region); err != nil {
+		return nil, errors.Join(ErrOrganizationGeneration, err)
+	}
+
+	return org, nil
+}
+
+// taxOfficeCodes returns the generator's region and tax office codes or random ones from the registry,
+// any code from 1 to 99 is used if the registry has no suitable values. The caller must hold the generator lock.
+func (g *Generator) taxOfficeCodes() (int, int, error) {
+	var regions []string
+	r, err := loadRegistry()
+	if err == nil {
+		regions = r.regionCodes
+	}
+
+	region := g.region
+	if region == 0 {
+		if region, err = g.randomCode(regions); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	var offices []string
+	if r != nil {
+		for _, code := range r.regionOffices[fmt.Sprintf("%02d", region)] {
+			offices = append(offices, code[regionLength:])
+		}
+	}
+
+	office := g.office
+	if office == 0 {
+		if office, err = g.randomCode(offices); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return region, office, nil
+}
+
+// randomCode returns a random two-digit code from codes or from 1 to 99 if codes are empty.
+// The caller must hold the generator lock.
+func (g *Generator) randomCode(codes []string) (int, error) {
+	if len(codes) == 0 {
+		n, err := randomInt(g.random(), maxCode)
+		return n + 1, err
+	}
+
+	i, err := randomInt(g.random(), len(codes))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(codes[i])
+}
+
+// companyName returns a random legal form and company name. The caller must hold the generator lock.
+func (g *Generator) companyName() (LegalForm, string, error) {
+	var indexes [3]int
+	for i, n := range []int{len(legalForms), len(companyNamePrefixes), len(companyNameSuffixes)} {
+		index, err := randomInt(g.random(), n)
+		if err != nil {
+			return LegalForm{}, "", err
+		}
+		indexes[i] = index
+	}
+
+	prefix, suffix := companyNamePrefixes[indexes[1]], companyNameSuffixes[indexes[2]]
+	if prefix == suffix {
+		suffix = companyNameSuffixes[(indexes[2]+1)%len(companyNameSuffixes)]
+	}
+
+	return legalForms[indexes[0]], prefix + suffix, nil
+}
+
+// GenerateOrganization generates a consistent legal entity with random region and tax office.
+func GenerateOrganization() (*Organization, error) {
+	return defaultGenerator.Organization()
+}
@@ -0,0 +1,68 @@
+package inn
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerateOrganization(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		org, err := GenerateOrganization()
+		if err != nil {
+			t.Fatalf("GenerateOrganization() error = %v", err)
+		}
+
+		if err = CheckOrganization(org.INN, org.KPP, org.OGRN); err != nil {
+			t.Errorf("CheckOrganization(%+v) error = %v", org, err)
+		}
+
+		if err = ValidateOKPO(org.OKPO); err != nil || len(org.OKPO) != OKPOLength {
+			t.Errorf("OKPO = %s, error = %v", org.OKPO, err)
+		}
+
+		if _, ok := RegionName(org.INN[:regionLength]); !ok {
+			t.Errorf("INN %s has unknown region", org.INN)
+		}
+
+		if org.KPP[:taxOfficeLength] != org.INN[:taxOfficeLength] || org.OGRN[3:7] != org.INN[:taxOfficeLength] {
+			t.Errorf("tax offices of %+v are different", org)
+		}
+
+		if org.Address == nil || org.Address.RegionCode != org.INN[:regionLength] {
+			t.Errorf("address %v is not in the INN %s region", org.Address, org.INN)
+		}
+
+		if !strings.HasPrefix(org.Name, org.LegalForm.Short+" «") || !strings.HasPrefix(org.FullName, org.LegalForm.Full) {
+			t.Errorf("names %q and %q do not match legal form %+v", org.Name, org.FullName, org.LegalForm)
+		}
+	}
+}
+
+func TestGenerator_Organization(t *testing.T) {
+	t.Parallel()
+
+	generate := func() *Organization {
+		g, err := NewGenerator(WithSeed(5), WithRegion(78), WithTaxOffice(42), WithRegistrationYear(2015))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+
+		org, err := g.Organization()
+		if err != nil {
+			t.Fatalf("Organization() error = %v", err)
+		}
+		return org
+	}
+
+	a, b := generate(), generate()
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Organization() with the same seed = %+v and %+v", a, b)
+	}
+
+	if !strings.HasPrefix(a.INN, "7842") || a.KPP != "784201001" || !strings.HasPrefix(a.OGRN, "1157842") {
+		t.Errorf("Organization() = %+v, want region 78 and tax office 42", a)
+	}
+}
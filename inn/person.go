@@ -0,0 +1,159 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// minPersonAge and maxPersonAge are the bounds of generated person ages.
+	minPersonAge = 18
+	maxPersonAge = 80
+
+	hoursPerDay = 24
+)
+
+// ErrPersonGeneration is an error indicating an error during person generation.
+var ErrPersonGeneration = errors.New("failed to generate person")
+
+// Gender is a gender of a physical person.
+type Gender string
+
+const (
+	// GenderMale is a male gender.
+	GenderMale Gender = "male"
+	// GenderFemale is a female gender.
+	GenderFemale Gender = "female"
+)
+
+// Person is a consistent set of physical person data: INN, SNILS, gender-consistent full name
+// and birth date. OGRNIP of an individual entrepreneur has the same region as INN and is registered
+// after the person's 18th birthday. The address is in the INN region, it is nil
+// if the address dataset has no cities in the region.
+type Person struct {
+	INN        string    `json:"inn"`
+	SNILS      string    `json:"snils"`
+	LastName   string    `json:"last_name"`
+	FirstName  string    `json:"first_name"`
+	Patronymic string    `json:"patronymic"`
+	Gender     Gender    `json:"gender"`
+	BirthDate  time.Time `json:"birth_date"`
+	OGRNIP     string    `json:"ogrnip,omitempty"`
+	Address    *Address  `json:"address,omitempty"`
+}
+
+// FullName returns the last name, first name and patronymic of the person.
+func (p *Person) FullName() string {
+	return strings.Join([]string{p.LastName, p.FirstName, p.Patronymic}, " ")
+}
+
+// Person generates a consistent physical person, the entrepreneur flag adds OGRNIP of an individual entrepreneur.
+// It uses the generator's region, tax office and registration year, random registry codes are used
+// for not configured region and tax office.
+func (g *Generator) Person(entrepreneur bool) (*Person, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	region, office, err := g.taxOfficeCodes()
+	if err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	p, err := g.personName()
+	if err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	latestYear := g.maxRandomYear()
+	if entrepreneur && g.year != 0 {
+		// OGRNIP of the configured year should be registered after the 18th birthday
+		latestYear = min(latestYear, g.year)
+	}
+
+	if p.BirthDate, err = g.birthDate(latestYear); err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	child := g.childFor(KindPhysical, region, office)
+
+	if p.INN, err = child.Physical(); err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	if p.SNILS, err = child.SNILS(); err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	if p.Address, err = g.regionAddress(region); err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	if !entrepreneur {
+		return p, nil
+	}
+
+	if child.year == 0 {
+		minYear := max(MinRegistrationYear, p.BirthDate.Year()+minPersonAge)
+		n, yearErr := randomInt(g.random(), g.maxRandomYear()-minYear+1)
+		if yearErr != nil {
+			return nil, errors.Join(ErrPersonGeneration, yearErr)
+		}
+		child.year = minYear + n
+	}
+
+	if p.OGRNIP, err = child.OGRNIP(); err != nil {
+		return nil, errors.Join(ErrPersonGeneration, err)
+	}
+
+	return p, nil
+}
+
+// personName returns a person with a random gender and gender-consistent full name.
+// The caller must hold the generator lock.
+func (g *Generator) personName() (*Person, error) {
+	var indexes [4]int
+	for i, n := range []int{2, len(lastNames), len(maleFirstNames), len(patronymics)} {
+		index, err := randomInt(g.random(), n)
+		if err != nil {
+			return nil, err
+		}
+		indexes[i] = index
+	}
+
+	if indexes[0] == 0 {
+		return &Person{
+			Gender:     GenderMale,
+			LastName:   lastNames[indexes[1]],
+			FirstName:  maleFirstNames[indexes[2]%len(maleFirstNames)],
+			Patronymic: patronymics[indexes[3]][0],
+		}, nil
+	}
+
+	return &Person{
+		Gender:     GenderFemale,
+		LastName:   feminineLastName(lastNames[indexes[1]]),
+		FirstName:  femaleFirstNames[indexes[2]%len(femaleFirstNames)],
+		Patronymic: patronymics[indexes[3]][1],
+	}, nil
+}
+
+// birthDate returns a random birth date in UTC of a person who is from 18 to 80 years old now
+// and is at least 18 years old in the latest year. The caller must hold the generator lock.
+func (g *Generator) birthDate(latestYear int) (time.Time, error) {
+	start := time.Date(g.maxRandomYear()-maxPersonAge, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(latestYear-minPersonAge, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	n, err := randomInt(g.random(), int(end.Sub(start).Hours()/hoursPerDay))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return start.AddDate(0, 0, n), nil
+}
+
+// GeneratePerson generates a consistent physical person with random region and tax office,
+// the entrepreneur flag adds OGRNIP of an individual entrepreneur.
+func GeneratePerson(entrepreneur bool) (*Person, error) {
+	return defaultGenerator.Person(entrepreneur)
+}
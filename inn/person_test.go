@@ -0,0 +1,131 @@
+package inn
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGeneratePerson(t *testing.T) {
+	t.Parallel()
+
+	for i := range 200 {
+		entrepreneur := i%2 == 0
+
+		p, err := GeneratePerson(entrepreneur)
+		if err != nil {
+			t.Fatalf("GeneratePerson() error = %v", err)
+		}
+
+		if err = NewValidator(p.INN, PhysicalLength).Validate(); err != nil {
+			t.Errorf("INN %s error = %v", p.INN, err)
+		}
+
+		if err = ValidateSNILS(p.SNILS); err != nil {
+			t.Errorf("SNILS %s error = %v", p.SNILS, err)
+		}
+
+		female := p.Gender == GenderFemale
+		femaleLastName := strings.HasSuffix(p.LastName, "а") || strings.HasSuffix(p.LastName, "ая")
+		if strings.HasSuffix(p.Patronymic, "на") != female || femaleLastName != female {
+			t.Errorf("full name %q does not match gender %s", p.FullName(), p.Gender)
+		}
+
+		if p.Address == nil || p.Address.RegionCode != p.INN[:regionLength] {
+			t.Errorf("address %v is not in the INN %s region", p.Address, p.INN)
+		}
+
+		if age := currentYear() - p.BirthDate.Year(); age < minPersonAge || age > maxPersonAge {
+			t.Errorf("birth date %v is out of range", p.BirthDate)
+		}
+
+		if !entrepreneur {
+			if p.OGRNIP != "" {
+				t.Errorf("OGRNIP = %s, want empty", p.OGRNIP)
+			}
+			continue
+		}
+
+		if err = CheckOrganization(p.INN, "", p.OGRNIP); err != nil {
+			t.Errorf("CheckOrganization(%s, %s) error = %v", p.INN, p.OGRNIP, err)
+		}
+
+		year, err := strconv.Atoi(p.OGRNIP[1:3])
+		if err != nil || 2000+year < p.BirthDate.Year()+minPersonAge {
+			t.Errorf("OGRNIP %s is registered before 18th birthday %v", p.OGRNIP, p.BirthDate)
+		}
+	}
+}
+
+func TestGenerator_Person(t *testing.T) {
+	t.Parallel()
+
+	generate := func() *Person {
+		g, err := NewGenerator(WithSeed(11), WithRegion(54))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+
+		p, err := g.Person(true)
+		if err != nil {
+			t.Fatalf("Person() error = %v", err)
+		}
+		return p
+	}
+
+	a, b := generate(), generate()
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Person() with the same seed = %+v and %+v", a, b)
+	}
+
+	if !strings.HasPrefix(a.INN, "54") || a.OGRNIP[3:5] != "54" {
+		t.Errorf("Person() = %+v, want region 54", a)
+	}
+}
+
+func TestGenerator_PersonRegistrationYear(t *testing.T) {
+	t.Parallel()
+
+	const year = 2020
+
+	for seed := range uint64(200) {
+		g, err := NewGenerator(WithSeed(seed), WithRegistrationYear(year))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+
+		p, err := g.Person(true)
+		if err != nil {
+			t.Fatalf("Person() error = %v", err)
+		}
+
+		if !strings.HasPrefix(p.OGRNIP, "320") {
+			t.Errorf("OGRNIP = %s, want registration year %d", p.OGRNIP, year)
+		}
+
+		if p.BirthDate.Year()+minPersonAge >= year {
+			t.Errorf("seed %d: birth date %v, want 18th birthday before %d", seed, p.BirthDate, year)
+		}
+	}
+}
+
+func TestFeminineLastName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Иванов", want: "Иванова"},
+		{name: "Никитин", want: "Никитина"},
+		{name: "Соловьёв", want: "Соловьёва"},
+		{name: "Покровский", want: "Покровская"},
+	}
+
+	for _, tt := range tests {
+		if got := feminineLastName(tt.name); got != tt.want {
+			t.Errorf("feminineLastName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
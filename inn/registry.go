@@ -0,0 +1,231 @@
+package inn
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	regionsFile    = "data/regions.tsv"
+	taxOfficesFile = "data/tax_offices.tsv"
+
+	// versionDirective is a required data file directive with the dataset version.
+	versionDirective = "version"
+	// completeDirective is a tax office file directive with space-separated region codes
+	// which office lists are complete, other regions can have unlisted offices.
+	completeDirective = "complete"
+)
+
+var (
+	//go:embed data/*.tsv
+	registryFS embed.FS
+
+	// loadRegistry loads the embedded registry only once.
+	loadRegistry = sync.OnceValues(newRegistry) //nolint:gochecknoglobals
+
+	// ErrInnTaxOffice is an error indicating an unknown INN tax office code.
+	ErrInnTaxOffice = errors.New("unknown INN tax office")
+)
+
+// registry is a dataset of region and tax office codes.
+type registry struct {
+	version    string
+	regions    map[string]string
+	taxOffices map[string]string
+	// regionCodes are sorted region codes without interregional ones.
+	regionCodes []string
+	// regionOffices are sorted tax inspection codes by region without regional offices (RR00)
+	// and the foreign organization prefix.
+	regionOffices map[string][]string
+	// completeRegions are region codes with complete tax office lists.
+	completeRegions map[string]bool
+}
+
+// newRegistry reads the embedded region and tax office datasets.
+func newRegistry() (*registry, error) {
+	regionsDirectives, regions, err := readRegistryFile(regionsFile, regionLength)
+	if err != nil {
+		return nil, err
+	}
+
+	officesDirectives, taxOffices, err := readRegistryFile(taxOfficesFile, taxOfficeLength)
+	if err != nil {
+		return nil, err
+	}
+
+	regionsVersion, officesVersion := regionsDirectives[versionDirective], officesDirectives[versionDirective]
+	if regionsVersion != officesVersion {
+		return nil, fmt.Errorf("registry version mismatch: %q and %q", regionsVersion, officesVersion)
+	}
+
+	r := &registry{
+		version:         regionsVersion,
+		regions:         regions,
+		taxOffices:      taxOffices,
+		regionOffices:   make(map[string][]string, len(regions)),
+		completeRegions: make(map[string]bool),
+	}
+
+	for _, code := range strings.Fields(officesDirectives[completeDirective]) {
+		if len(code) != regionLength || !isDigits(code) {
+			return nil, fmt.Errorf("invalid complete region code %q in %s", code, taxOfficesFile)
+		}
+		r.completeRegions[code] = true
+	}
+
+	for code := range regions {
+		if code != interregionalPrefix {
+			r.regionCodes = append(r.regionCodes, code)
+		}
+	}
+	slices.Sort(r.regionCodes)
+
+	for code := range taxOffices {
+		// the foreign organization prefix is not an inspection of generated juridical and physical INNs
+		if region := code[:regionLength]; code[regionLength:] != "00" && code != foreignPrefix {
+			r.regionOffices[region] = append(r.regionOffices[region], code)
+		}
+	}
+
+	for _, codes := range r.regionOffices {
+		slices.Sort(codes)
+	}
+
+	return r, nil
+}
+
+// readRegistryFile reads "code<TAB>name" lines and directives of the embedded file.
+func readRegistryFile(name string, codeLength int) (map[string]string, map[string]string, error) {
+	items := make(map[string]string)
+
+	directives, err := readDataFile(name, func(n int, line string) error {
+		code, title, ok := strings.Cut(line, "\t")
+		if !ok || len(code) != codeLength || !isDigits(code) || title == "" {
+			return fmt.Errorf("invalid line %d in %s: %q", n, name, line)
+		}
+
+		if _, exists := items[code]; exists {
+			return fmt.Errorf("duplicate code %s at line %d in %s", code, n, name)
+		}
+
+		items[code] = title
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return directives, items, nil
+}
+
+// readDataFile calls the function for every data line of the embedded file with its number
+// and returns the file directives. Comments start with "#", comments "# key: value" with a one-word key
+// are directives, the version directive is required.
+func readDataFile(name string, fn func(n int, line string) error) (map[string]string, error) {
+	data, err := registryFS.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	var (
+		directives = make(map[string]string)
+		scanner    = bufio.NewScanner(bytes.NewReader(data))
+	)
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if comment, ok := strings.CutPrefix(line, "#"); ok {
+			key, value, found := strings.Cut(strings.TrimSpace(comment), ":")
+			if found && key != "" && !strings.ContainsFunc(key, unicode.IsSpace) {
+				directives[key] = strings.TrimSpace(value)
+			}
+			continue
+		}
+
+		if line == "" {
+			continue
+		}
+
+		if err = fn(n, line); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", name, err)
+	}
+
+	if directives[versionDirective] == "" {
+		return nil, fmt.Errorf("no version in %s", name)
+	}
+
+	return directives, nil
+}
+
+// RegistryVersion returns a version of the embedded region and tax office registry.
+func RegistryVersion() string {
+	r, err := loadRegistry()
+	if err != nil {
+		return ""
+	}
+	return r.version
+}
+
+// checkTaxOffice returns ErrInnTaxOffice if the four-digit tax office code does not exist,
+// it is absent in the registry and the registry has a complete office list of its region.
+// Unlisted codes of other regions can be real, so they are not rejected.
+func checkTaxOffice(code string) error {
+	r, err := loadRegistry()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInnTaxOffice, err)
+	}
+
+	if _, ok := r.taxOffices[code]; ok || !r.completeRegions[code[:regionLength]] {
+		return nil
+	}
+	return fmt.Errorf("%w: code %s is not found in registry version %s", ErrInnTaxOffice, code, r.version)
+}
+
+// RegionName returns a federal subject name by its two-digit code.
+func RegionName(code string) (string, bool) {
+	r, err := loadRegistry()
+	if err != nil {
+		return "", false
+	}
+
+	name, ok := r.regions[code]
+	return name, ok
+}
+
+// TaxOfficeName returns a tax inspection name by its four-digit code (SOUN).
+func TaxOfficeName(code string) (string, bool) {
+	r, err := loadRegistry()
+	if err != nil {
+		return "", false
+	}
+
+	name, ok := r.taxOffices[code]
+	return name, ok
+}
+
+// isDigits returns true if the string is not empty and contains only ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
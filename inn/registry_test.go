@@ -0,0 +1,132 @@
+package inn
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	r, err := newRegistry()
+	if err != nil {
+		t.Fatalf("newRegistry() error = %v", err)
+	}
+
+	if r.version == "" {
+		t.Error("registry version is empty")
+	}
+
+	for code := range r.taxOffices {
+		if _, ok := r.regions[code[:regionLength]]; !ok {
+			t.Errorf("tax office %s has unknown region", code)
+		}
+	}
+
+	if slices.Contains(r.regionCodes, interregionalPrefix) || !slices.IsSorted(r.regionCodes) {
+		t.Errorf("region codes %v should be sorted and exclude %s", r.regionCodes, interregionalPrefix)
+	}
+
+	for region, codes := range r.regionOffices {
+		if slices.Contains(codes, region+"00") || slices.Contains(codes, foreignPrefix) || !slices.IsSorted(codes) {
+			t.Errorf("tax offices %v should be sorted and exclude %s00 and %s", codes, region, foreignPrefix)
+		}
+	}
+
+	if !r.completeRegions["77"] || r.completeRegions["50"] {
+		t.Errorf("complete regions = %v, want Moscow without Moscow region", r.completeRegions)
+	}
+
+	// new federal subjects should be included
+	for _, code := range []string{"90", "91", "92", "93", "94", "95"} {
+		if _, ok := r.regions[code]; !ok {
+			t.Errorf("region %s is not found", code)
+		}
+	}
+}
+
+func TestRegionName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code   string
+		want   string
+		wantOk bool
+	}{
+		{code: "77", want: "г. Москва", wantOk: true},
+		{code: "78", want: "г. Санкт-Петербург", wantOk: true},
+		{code: "01", want: "Республика Адыгея", wantOk: true},
+		{code: "93", want: "Донецкая Народная Республика", wantOk: true},
+		{code: "00"},
+		{code: "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := RegionName(tt.code)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("RegionName() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTaxOfficeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code   string
+		want   string
+		wantOk bool
+	}{
+		{code: "7707", want: "ИФНС России № 7 по г. Москве", wantOk: true},
+		{code: "7700", want: "УФНС России по г. Москве", wantOk: true},
+		{code: "7799"},
+		{code: "0000"},
+		{code: "77"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := TaxOfficeName(tt.code)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("TaxOfficeName() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inn     string
+		wantErr error
+	}{
+		{name: "known tax office", inn: "7707083893"},
+		{name: "known physical tax office", inn: "770734275852"},
+		{name: "unknown Moscow tax office", inn: "7799000012", wantErr: ErrInnTaxOffice},
+		{name: "unlisted tax office of a partial region", inn: "5504036333"},
+		{name: "unlisted physical tax office of a partial region", inn: "500100732259"},
+		{name: "zero tax office", inn: "0000000000", wantErr: ErrInnTaxOffice},
+		{name: "invalid checksum", inn: "7707083892", wantErr: ErrInnChecksum},
+		{name: "invalid length", inn: "77", wantErr: ErrInnLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewValidator(tt.inn, 0).ValidateStrict()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
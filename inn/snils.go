@@ -0,0 +1,153 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SNILSLength is the valid number of SNILS digits.
+	SNILSLength = 11
+
+	// snilsNumberLength is the number of SNILS digits without the control sum.
+	snilsNumberLength = 9
+	// snilsMinChecked is the maximum SNILS number without the control sum check.
+	snilsMinChecked = 1001998
+	// snilsModulus is the modulus of the SNILS control sum.
+	snilsModulus = 101
+	// snilsMaxRepeats is the maximum number of identical adjacent digits in the SNILS number.
+	snilsMaxRepeats = 2
+	// snilsMaxAttempts is the maximum number of attempts to generate a SNILS number.
+	snilsMaxAttempts = 1000
+)
+
+var (
+	// ErrSnilsLength is an error indicating an invalid SNILS length.
+	ErrSnilsLength = errors.New("invalid SNILS length")
+	// ErrSnilsFormat is an error indicating a non-digit character in SNILS.
+	ErrSnilsFormat = errors.New("invalid SNILS format")
+	// ErrSnilsChecksum is an error indicating an invalid SNILS control sum.
+	ErrSnilsChecksum = errors.New("invalid SNILS checksum")
+	// ErrSnilsGeneration is an error indicating an error during SNILS generation.
+	ErrSnilsGeneration = errors.New("failed to generate SNILS")
+)
+
+// ValidateSNILS checks the correctness of an insurance account number (SNILS).
+// Dashes and spaces are ignored, so both "11223344595" and "112-233-445 95" are accepted,
+// positions in validation errors are positions of digits. Numbers up to 001-001-998 have no control sum.
+func ValidateSNILS(snils string) error {
+	_, err := parseSNILS(snils)
+	return err
+}
+
+// FormatSNILS validates SNILS and returns it in the canonical "XXX-XXX-XXX YY" form.
+func FormatSNILS(snils string) (string, error) {
+	value, err := parseSNILS(snils)
+	if err != nil {
+		return "", err
+	}
+	return formatSNILS(value), nil
+}
+
+// parseSNILS validates SNILS and returns its digits without separators.
+func parseSNILS(snils string) (string, error) {
+	value := strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(snils))
+
+	if n := len(value); n != SNILSLength {
+		return "", newLengthError(
+			ErrSnilsLength, strconv.Itoa(SNILSLength), n, fmt.Sprintf("got %d digits, expected %d", n, SNILSLength),
+		)
+	}
+
+	digits, err := parseDigits(value, ErrSnilsFormat)
+	if err != nil {
+		return "", err
+	}
+
+	number, _ := strconv.Atoi(value[:snilsNumberLength])
+	if number <= snilsMinChecked {
+		return value, nil
+	}
+
+	controlSum := snilsControlSum(digits[:snilsNumberLength])
+	if actual := digits[9]*10 + digits[10]; controlSum != actual {
+		return "", &ValidationError{
+			Err:      ErrSnilsChecksum,
+			Kind:     ErrorKindChecksum,
+			Position: snilsNumberLength + 1,
+			Expected: fmt.Sprintf("%02d", controlSum),
+			Actual:   value[snilsNumberLength:],
+			Message:  fmt.Sprintf("expected control sum %02d, got %s", controlSum, value[snilsNumberLength:]),
+		}
+	}
+
+	return value, nil
+}
+
+// snilsControlSum calculates the two-digit control sum of 9 SNILS number digits with weights from 9 to 1.
+func snilsControlSum(digits []int) int {
+	sum := 0
+	for i, d := range digits {
+		sum += d * (snilsNumberLength - i)
+	}
+
+	if sum >= snilsModulus {
+		sum %= snilsModulus
+	}
+
+	if sum >= snilsModulus-1 {
+		return 0
+	}
+	return sum
+}
+
+// formatSNILS returns 11 SNILS digits in the canonical "XXX-XXX-XXX YY" form.
+func formatSNILS(value string) string {
+	return value[:3] + "-" + value[3:6] + "-" + value[6:9] + " " + value[9:]
+}
+
+// SNILS generates a valid 11-digit SNILS without separators, the number is greater than 001-001-998
+// and does not contain more than two identical adjacent digits.
+func (g *Generator) SNILS() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for range snilsMaxAttempts {
+		digits, err := randomDigits(g.random(), snilsNumberLength)
+		if err != nil {
+			return "", errors.Join(ErrSnilsGeneration, err)
+		}
+
+		value := digitsToString(digits)
+		if number, _ := strconv.Atoi(value); number <= snilsMinChecked || hasRepeats(digits, snilsMaxRepeats) {
+			continue
+		}
+
+		return fmt.Sprintf("%s%02d", value, snilsControlSum(digits)), nil
+	}
+
+	return "", fmt.Errorf("%w: no suitable number after %d attempts", ErrSnilsGeneration, snilsMaxAttempts)
+}
+
+// hasRepeats returns true if digits contain more than maxRepeats identical adjacent digits.
+func hasRepeats(digits []int, maxRepeats int) bool {
+	repeats := 1
+	for i := 1; i < len(digits); i++ {
+		if digits[i] != digits[i-1] {
+			repeats = 1
+			continue
+		}
+
+		if repeats++; repeats > maxRepeats {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateSNILS generates a valid 11-digit SNILS without separators, use FormatSNILS for the canonical form.
+func GenerateSNILS() (string, error) {
+	return defaultGenerator.SNILS()
+}
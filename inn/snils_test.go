@@ -0,0 +1,129 @@
+package inn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateSNILS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		snils    string
+		want     string
+		wantErr  error
+		wantKind ErrorKind
+		wantPos  int
+	}{
+		{name: "digits", snils: "11223344595", want: "112-233-445 95"},
+		{name: "canonical", snils: " 112-233-445 95 ", want: "112-233-445 95"},
+		{name: "large sum", snils: "98765432183", want: "987-654-321 83"},
+		{name: "sum 100", snils: "05023431600", want: "050-234-316 00"},
+		{name: "sum 101", snils: "01610339600", want: "016-103-396 00"},
+		{name: "remainder 100", snils: "82098123300", want: "820-981-233 00"},
+		{name: "without check", snils: "001-001-998 12", want: "001-001-998 12"},
+		{name: "first checked", snils: "001-001-999 65", want: "001-001-999 65"},
+		{name: "short", snils: "112-233-445 9", wantErr: ErrSnilsLength, wantKind: ErrorKindLength},
+		{name: "letter", snils: "112-233-4X5 95", wantErr: ErrSnilsFormat, wantKind: ErrorKindFormat, wantPos: 8},
+		{name: "checksum", snils: "112-233-445 59", wantErr: ErrSnilsChecksum, wantKind: ErrorKindChecksum, wantPos: 10},
+		{name: "first checked checksum", snils: "00100199912", wantErr: ErrSnilsChecksum, wantKind: ErrorKindChecksum, wantPos: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FormatSNILS(tt.snils)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FormatSNILS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("FormatSNILS() = %q, want %q", got, tt.want)
+			}
+
+			if err = ValidateSNILS(tt.snils); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateSNILS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil {
+				return
+			}
+
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("error %v is not a ValidationError", err)
+			}
+
+			if ve.Kind != tt.wantKind || ve.Position != tt.wantPos {
+				t.Errorf("ValidationError = %+v, want kind %q at position %d", ve, tt.wantKind, tt.wantPos)
+			}
+		})
+	}
+}
+
+func TestGenerateSNILS(t *testing.T) {
+	t.Parallel()
+
+	for range 1000 {
+		snils, err := GenerateSNILS()
+		if err != nil {
+			t.Fatalf("GenerateSNILS() error = %v", err)
+		}
+
+		if err = ValidateSNILS(snils); err != nil {
+			t.Errorf("ValidateSNILS(%s) error = %v", snils, err)
+		}
+
+		digits, err := parseDigits(snils[:snilsNumberLength], ErrSnilsFormat)
+		if err != nil {
+			t.Fatalf("parseDigits() error = %v", err)
+		}
+
+		if hasRepeats(digits, snilsMaxRepeats) {
+			t.Errorf("GenerateSNILS() = %s, contains three identical digits in a row", snils)
+		}
+	}
+}
+
+func TestGenerator_SNILS(t *testing.T) {
+	t.Parallel()
+
+	generate := func() string {
+		g, err := NewGenerator(WithSeed(7))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+
+		snils, err := g.SNILS()
+		if err != nil {
+			t.Fatalf("SNILS() error = %v", err)
+		}
+		return snils
+	}
+
+	if a, b := generate(), generate(); a != b {
+		t.Errorf("SNILS() with the same seed = %s and %s", a, b)
+	}
+}
+
+func TestHasRepeats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		digits []int
+		want   bool
+	}{
+		{digits: []int{1, 1, 2, 2, 3, 3}, want: false},
+		{digits: []int{1, 2, 2, 2, 3}, want: true},
+		{digits: []int{0, 0, 0}, want: true},
+		{digits: []int{5}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := hasRepeats(tt.digits, snilsMaxRepeats); got != tt.want {
+			t.Errorf("hasRepeats(%v) = %v, want %v", tt.digits, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package inn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dialect is a SQL dialect of generated INN check functions.
+type Dialect string
+
+const (
+	// DialectPostgres is a PostgreSQL dialect.
+	DialectPostgres Dialect = "postgres"
+	// DialectSQLite is a SQLite dialect.
+	DialectSQLite Dialect = "sqlite"
+
+	// DefaultColumn is a default column name in the CHECK constraint template.
+	DefaultColumn = "inn"
+
+	// whitespaceNote is a SQL comment about the only difference from Validator.
+	whitespaceNote = "-- Unlike inn.Validator, surrounding whitespace is not trimmed, store trimmed INNs.\n"
+)
+
+var (
+	// identifierRegexp is a pattern of SQL identifiers which can be used without quoting.
+	identifierRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`) //nolint:gochecknoglobals
+
+	// ErrUnknownDialect is an error indicating an unknown SQL dialect.
+	ErrUnknownDialect = errors.New("unknown SQL dialect")
+	// ErrInvalidColumn is an error indicating an invalid SQL column name.
+	ErrInvalidColumn = errors.New("invalid SQL column name")
+)
+
+// ParseDialect returns a SQL dialect by its name.
+func ParseDialect(name string) (Dialect, error) {
+	switch d := Dialect(strings.ToLower(strings.TrimSpace(name))); d {
+	case DialectPostgres, DialectSQLite:
+		return d, nil
+	case "postgresql", "pg":
+		return DialectPostgres, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownDialect, name)
+	}
+}
+
+// GenerateSQL returns SQL which checks INNs with the same rules as Validator and a CHECK constraint
+// template for the column. Unlike Validator, surrounding whitespace is not trimmed, such values are invalid. PostgreSQL gets the is_valid_inn(text) function, SQLite does not support
+// user-defined SQL functions, so the check is inlined into the constraint expression.
+func GenerateSQL(dialect Dialect, column string) (string, error) {
+	if !identifierRegexp.MatchString(column) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidColumn, column)
+	}
+
+	switch dialect {
+	case DialectPostgres:
+		return postgresSQL(column), nil
+	case DialectSQLite:
+		return sqliteSQL(column), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownDialect, dialect)
+	}
+}
+
+// postgresSQL returns PostgreSQL functions and the CHECK constraint template.
+func postgresSQL(column string) string {
+	var b strings.Builder
+
+	b.WriteString("-- INN validation functions generated by INNGen, the same rules as inn.Validator.\n")
+	b.WriteString(whitespaceNote)
+	b.WriteString("CREATE OR REPLACE FUNCTION inn_control_value(inn text, weights int[]) RETURNS int\n")
+	b.WriteString("LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE AS $$\n")
+	b.WriteString("    SELECT ((sum(substr(inn, i, 1)::int * weights[i]) % 11) % 10)::int\n")
+	b.WriteString("    FROM generate_subscripts(weights, 1) AS i\n")
+	b.WriteString("$$;\n\n")
+
+	b.WriteString("CREATE OR REPLACE FUNCTION is_valid_inn(inn text) RETURNS boolean\n")
+	b.WriteString("LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE AS $$\n")
+	b.WriteString("    SELECT CASE\n")
+	fmt.Fprintf(&b, "        WHEN inn ~ '^[0-9]{%d}$' THEN\n", JuridicalLength)
+	fmt.Fprintf(&b, "            %s\n", postgresCheck(weightsJuridical, JuridicalLength))
+	fmt.Fprintf(&b, "            AND %s\n", kioCheck("inn"))
+	fmt.Fprintf(&b, "        WHEN inn ~ '^[0-9]{%d}$' THEN\n", PhysicalLength)
+	fmt.Fprintf(&b, "            %s\n", postgresCheck(weightsPhysical1, PhysicalLength-1))
+	fmt.Fprintf(&b, "            AND %s\n", postgresCheck(weightsPhysical2, PhysicalLength))
+	b.WriteString("        ELSE false\n")
+	b.WriteString("    END\n")
+	b.WriteString("$$;\n\n")
+
+	b.WriteString("-- CHECK constraint template:\n")
+	fmt.Fprintf(&b, "-- ALTER TABLE <table> ADD CONSTRAINT <table>_%[1]s_check CHECK (is_valid_inn(%[1]s));\n", column)
+
+	return b.String()
+}
+
+// postgresCheck returns an expression comparing a control value with the digit at the 1-based position.
+func postgresCheck(weights []int, position int) string {
+	return fmt.Sprintf(
+		"inn_control_value(inn, ARRAY[%s]) = substr(inn, %d, 1)::int",
+		joinInts(weights, ","), position,
+	)
+}
+
+// sqliteSQL returns the SQLite CHECK constraint template with inlined checksum expressions.
+func sqliteSQL(column string) string {
+	var b strings.Builder
+
+	b.WriteString("-- INN CHECK constraint generated by INNGen, the same rules as inn.Validator.\n")
+	b.WriteString(whitespaceNote)
+	b.WriteString("-- SQLite does not support SQL functions, add this constraint to a table definition:\n")
+	fmt.Fprintf(&b, "-- CREATE TABLE <table> (..., %s TEXT, ...,\n", column)
+	fmt.Fprintf(&b, "CONSTRAINT %s_check CHECK (\n", column)
+	fmt.Fprintf(&b, "    %s IS NULL\n", column)
+	fmt.Fprintf(&b, "    OR (length(%[1]s) = %[2]d AND %[1]s NOT GLOB '*[^0-9]*'\n", column, JuridicalLength)
+	fmt.Fprintf(&b, "        AND %s\n", sqliteCheck(column, weightsJuridical, JuridicalLength))
+	fmt.Fprintf(&b, "        AND %s)\n", kioCheck(column))
+	fmt.Fprintf(&b, "    OR (length(%[1]s) = %[2]d AND %[1]s NOT GLOB '*[^0-9]*'\n", column, PhysicalLength)
+	fmt.Fprintf(&b, "        AND %s\n", sqliteCheck(column, weightsPhysical1, PhysicalLength-1))
+	fmt.Fprintf(&b, "        AND %s)\n", sqliteCheck(column, weightsPhysical2, PhysicalLength))
+	b.WriteString(")\n")
+	b.WriteString("-- );\n")
+
+	return b.String()
+}
+
+// sqliteCheck returns an expression comparing a control value with the digit at the 1-based position.
+func sqliteCheck(column string, weights []int, position int) string {
+	terms := make([]string, 0, len(weights))
+	for i, w := range weights {
+		if w != 0 {
+			terms = append(terms, fmt.Sprintf("CAST(substr(%s, %d, 1) AS INTEGER) * %d", column, i+1, w))
+		}
+	}
+
+	return fmt.Sprintf(
+		"((%s) %% 11) %% 10 = CAST(substr(%s, %d, 1) AS INTEGER)",
+		strings.Join(terms, " + "), column, position,
+	)
+}
+
+// kioCheck returns an expression rejecting the unassigned KIO 00000 after the 9909 prefix,
+// it is the same in both dialects.
+func kioCheck(column string) string {
+	return fmt.Sprintf(
+		"(substr(%[1]s, 1, %[2]d) <> '%[3]s' OR substr(%[1]s, %[4]d, %[5]d) <> '%[6]s')",
+		column, len(foreignPrefix), foreignPrefix, len(foreignPrefix)+1, kioLength, strings.Repeat("0", kioLength),
+	)
+}
+
+// joinInts joins integers with the separator.
+func joinInts(values []int, sep string) string {
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = strconv.Itoa(v)
+	}
+	return strings.Join(items, sep)
+}
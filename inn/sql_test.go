@@ -0,0 +1,142 @@
+package inn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDialect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		want    Dialect
+		wantErr error
+	}{
+		{name: "postgres", want: DialectPostgres},
+		{name: "PostgreSQL", want: DialectPostgres},
+		{name: "pg", want: DialectPostgres},
+		{name: "sqlite", want: DialectSQLite},
+		{name: "mysql", wantErr: ErrUnknownDialect},
+		{name: "", wantErr: ErrUnknownDialect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDialect(tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseDialect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDialect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateSQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		dialect        Dialect
+		column         string
+		wantSubstrings []string
+		wantErr        error
+	}{
+		{
+			name:    "postgres",
+			dialect: DialectPostgres,
+			column:  DefaultColumn,
+			wantSubstrings: []string{
+				"CREATE OR REPLACE FUNCTION is_valid_inn(inn text) RETURNS boolean",
+				"ARRAY[2,4,10,3,5,9,4,6,8,0]) = substr(inn, 10, 1)::int",
+				"ARRAY[7,2,4,10,3,5,9,4,6,8,0]) = substr(inn, 11, 1)::int",
+				"ARRAY[3,7,2,4,10,3,5,9,4,6,8,0]) = substr(inn, 12, 1)::int",
+				"AND (substr(inn, 1, 4) <> '9909' OR substr(inn, 5, 5) <> '00000')",
+				"CHECK (is_valid_inn(inn))",
+				"surrounding whitespace is not trimmed",
+			},
+		},
+		{
+			name:    "sqlite custom column",
+			dialect: DialectSQLite,
+			column:  "tax_id",
+			wantSubstrings: []string{
+				"CONSTRAINT tax_id_check CHECK (",
+				"tax_id IS NULL",
+				"length(tax_id) = 10 AND tax_id NOT GLOB '*[^0-9]*'",
+				"CAST(substr(tax_id, 9, 1) AS INTEGER) * 8) % 11) % 10 = CAST(substr(tax_id, 10, 1) AS INTEGER)",
+				"CAST(substr(tax_id, 11, 1) AS INTEGER) * 8) % 11) % 10 = CAST(substr(tax_id, 12, 1) AS INTEGER)",
+				"AND (substr(tax_id, 1, 4) <> '9909' OR substr(tax_id, 5, 5) <> '00000'))",
+			},
+		},
+		{
+			name:    "unknown dialect",
+			dialect: "oracle",
+			column:  DefaultColumn,
+			wantErr: ErrUnknownDialect,
+		},
+		{
+			name:    "sql injection in column",
+			dialect: DialectPostgres,
+			column:  "inn); DROP TABLE users; --",
+			wantErr: ErrInvalidColumn,
+		},
+		{
+			name:    "empty column",
+			dialect: DialectSQLite,
+			wantErr: ErrInvalidColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateSQL(tt.dialect, tt.column)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GenerateSQL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, substr := range tt.wantSubstrings {
+				if !strings.Contains(got, substr) {
+					t.Errorf("GenerateSQL() = %s\nwant to contain %q", got, substr)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSQL_SQLite(t *testing.T) {
+	t.Parallel()
+
+	// the expected constraint was run in sqlite3: valid INNs and NULL are accepted,
+	// invalid checksums, KIO 00000, non-digits, empty strings and surrounding spaces are rejected
+	want := "-- INN CHECK constraint generated by INNGen, the same rules as inn.Validator.\n" +
+		"-- Unlike inn.Validator, surrounding whitespace is not trimmed, store trimmed INNs.\n" +
+		"-- SQLite does not support SQL functions, add this constraint to a table definition:\n" +
+		"-- CREATE TABLE <table> (..., tax_id TEXT, ...,\n" +
+		"CONSTRAINT tax_id_check CHECK (\n" +
+		"    tax_id IS NULL\n" +
+		"    OR (length(tax_id) = 10 AND tax_id NOT GLOB '*[^0-9]*'\n" +
+		"        AND ((CAST(substr(tax_id, 1, 1) AS INTEGER) * 2 + CAST(substr(tax_id, 2, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 3, 1) AS INTEGER) * 10 + CAST(substr(tax_id, 4, 1) AS INTEGER) * 3 + CAST(substr(tax_id, 5, 1) AS INTEGER) * 5 + CAST(substr(tax_id, 6, 1) AS INTEGER) * 9 + CAST(substr(tax_id, 7, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 8, 1) AS INTEGER) * 6 + CAST(substr(tax_id, 9, 1) AS INTEGER) * 8) % 11) % 10 = CAST(substr(tax_id, 10, 1) AS INTEGER)\n" +
+		"        AND (substr(tax_id, 1, 4) <> '9909' OR substr(tax_id, 5, 5) <> '00000'))\n" +
+		"    OR (length(tax_id) = 12 AND tax_id NOT GLOB '*[^0-9]*'\n" +
+		"        AND ((CAST(substr(tax_id, 1, 1) AS INTEGER) * 7 + CAST(substr(tax_id, 2, 1) AS INTEGER) * 2 + CAST(substr(tax_id, 3, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 4, 1) AS INTEGER) * 10 + CAST(substr(tax_id, 5, 1) AS INTEGER) * 3 + CAST(substr(tax_id, 6, 1) AS INTEGER) * 5 + CAST(substr(tax_id, 7, 1) AS INTEGER) * 9 + CAST(substr(tax_id, 8, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 9, 1) AS INTEGER) * 6 + CAST(substr(tax_id, 10, 1) AS INTEGER) * 8) % 11) % 10 = CAST(substr(tax_id, 11, 1) AS INTEGER)\n" +
+		"        AND ((CAST(substr(tax_id, 1, 1) AS INTEGER) * 3 + CAST(substr(tax_id, 2, 1) AS INTEGER) * 7 + CAST(substr(tax_id, 3, 1) AS INTEGER) * 2 + CAST(substr(tax_id, 4, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 5, 1) AS INTEGER) * 10 + CAST(substr(tax_id, 6, 1) AS INTEGER) * 3 + CAST(substr(tax_id, 7, 1) AS INTEGER) * 5 + CAST(substr(tax_id, 8, 1) AS INTEGER) * 9 + CAST(substr(tax_id, 9, 1) AS INTEGER) * 4 + CAST(substr(tax_id, 10, 1) AS INTEGER) * 6 + CAST(substr(tax_id, 11, 1) AS INTEGER) * 8) % 11) % 10 = CAST(substr(tax_id, 12, 1) AS INTEGER))\n" +
+		")\n" +
+		"-- );\n"
+
+	got, err := GenerateSQL(DialectSQLite, "tax_id")
+	if err != nil {
+		t.Fatalf("GenerateSQL() error = %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GenerateSQL() = %s\nwant %s", got, want)
+	}
+}
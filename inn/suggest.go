@@ -0,0 +1,122 @@
+package inn
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// MaxSuggestions is the maximum number of suggestions returned by Suggest.
+const MaxSuggestions = 10
+
+// SuggestionReason is a kind of typo which was corrected by a suggestion.
+type SuggestionReason string
+
+const (
+	// ReasonTransposition is a swap of two adjacent digits.
+	ReasonTransposition SuggestionReason = "transposition"
+	// ReasonOCR is a digit confused with a similar looking one (3/8, 1/7, 0/6).
+	ReasonOCR SuggestionReason = "ocr"
+	// ReasonSubstitution is any other single substituted digit.
+	ReasonSubstitution SuggestionReason = "substitution"
+)
+
+// confusions are pairs of digits which are often confused by OCR or by eye.
+var confusions = map[byte][]byte{ //nolint:gochecknoglobals
+	'0': {'6'},
+	'1': {'7'},
+	'3': {'8'},
+	'6': {'0'},
+	'7': {'1'},
+	'8': {'3'},
+}
+
+// Suggestion is a valid INN which differs from the invalid one by a single typo.
+// Position is a 1-based position of the first changed digit.
+type Suggestion struct {
+	INN      string           `json:"inn"`
+	Reason   SuggestionReason `json:"reason"`
+	Position int              `json:"position"`
+}
+
+// rank returns a likelihood rank of the reason, a lower value is more likely.
+func (r SuggestionReason) rank() int {
+	switch r {
+	case ReasonTransposition:
+		return 0
+	case ReasonOCR:
+		return 1
+	default:
+		return 2 //nolint:mnd
+	}
+}
+
+// Suggest returns valid INNs reachable from the INN with invalid checksum by one adjacent
+// transposition, one OCR confusion (3/8, 1/7, 0/6) or one substituted digit, ranked by likelihood.
+// It returns nil if the INN is valid or its length or format is invalid.
+func Suggest(inn string) []Suggestion {
+	value := strings.TrimSpace(inn)
+	if vErr, ok := AsValidationError(NewValidator(value, 0).Validate()); !ok || vErr.Kind != ErrorKindChecksum {
+		return nil
+	}
+
+	var (
+		found       = make(map[string]int)
+		suggestions []Suggestion
+		buf         = []byte(value)
+	)
+
+	add := func(reason SuggestionReason, position int) {
+		candidate := string(buf)
+		if NewValidator(candidate, len(candidate)).Validate() != nil {
+			return
+		}
+
+		if i, ok := found[candidate]; ok {
+			if reason.rank() < suggestions[i].Reason.rank() {
+				suggestions[i].Reason = reason
+			}
+			return
+		}
+
+		found[candidate] = len(suggestions)
+		suggestions = append(suggestions, Suggestion{INN: candidate, Reason: reason, Position: position})
+	}
+
+	for i := range len(buf) - 1 {
+		if buf[i] == buf[i+1] {
+			continue
+		}
+
+		buf[i], buf[i+1] = buf[i+1], buf[i]
+		add(ReasonTransposition, i+1)
+		buf[i], buf[i+1] = buf[i+1], buf[i]
+	}
+
+	for i, original := range []byte(value) {
+		for d := byte('0'); d <= '9'; d++ {
+			if d == original {
+				continue
+			}
+
+			reason := ReasonSubstitution
+			if slices.Contains(confusions[original], d) {
+				reason = ReasonOCR
+			}
+
+			buf[i] = d
+			add(reason, i+1)
+		}
+		buf[i] = original
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b Suggestion) int {
+		return cmp.Compare(a.Reason.rank(), b.Reason.rank())
+	})
+
+	if len(suggestions) > MaxSuggestions {
+		suggestions = suggestions[:MaxSuggestions]
+	}
+
+	return suggestions
+}
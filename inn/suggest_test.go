@@ -0,0 +1,94 @@
+package inn
+
+import "testing"
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inn       string
+		wantFirst Suggestion
+		wantEmpty bool
+	}{
+		{
+			name:      "adjacent transposition",
+			inn:       "7707803893",
+			wantFirst: Suggestion{INN: "7707083893", Reason: ReasonTransposition, Position: 5},
+		},
+		{
+			name:      "ocr confusion 3 and 8",
+			inn:       "7707088893",
+			wantFirst: Suggestion{INN: "7707083893", Reason: ReasonOCR, Position: 7},
+		},
+		{
+			name:      "physical check digit",
+			inn:       " 500100732250 ",
+			wantFirst: Suggestion{INN: "500100732259", Reason: ReasonSubstitution, Position: 12},
+		},
+		{
+			name:      "valid INN",
+			inn:       "7707083893",
+			wantEmpty: true,
+		},
+		{
+			name:      "invalid length",
+			inn:       "5001007322590",
+			wantEmpty: true,
+		},
+		{
+			name:      "invalid format",
+			inn:       "770708389A",
+			wantEmpty: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Suggest(tt.inn)
+			if tt.wantEmpty {
+				if len(got) != 0 {
+					t.Errorf("Suggest() = %v, want empty", got)
+				}
+				return
+			}
+
+			if len(got) == 0 {
+				t.Fatal("Suggest() returned no suggestions")
+			}
+
+			if got[0] != tt.wantFirst {
+				t.Errorf("Suggest()[0] = %+v, want %+v", got[0], tt.wantFirst)
+			}
+
+			if len(got) > MaxSuggestions {
+				t.Errorf("Suggest() returned %d suggestions, want at most %d", len(got), MaxSuggestions)
+			}
+
+			seen := make(map[string]struct{}, len(got))
+			for i, s := range got {
+				if err := NewValidator(s.INN, 0).Validate(); err != nil {
+					t.Errorf("suggestion %s is invalid: %v", s.INN, err)
+				}
+
+				if _, ok := seen[s.INN]; ok {
+					t.Errorf("duplicate suggestion %s", s.INN)
+				}
+				seen[s.INN] = struct{}{}
+
+				if i > 0 && s.Reason.rank() < got[i-1].Reason.rank() {
+					t.Errorf("suggestion %d (%s) is ranked after a less likely one (%s)", i, s.Reason, got[i-1].Reason)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSuggest(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Suggest("500100732250")
+	}
+}
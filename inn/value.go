@@ -0,0 +1,171 @@
+package inn
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// Compile-time checks that INN implements marshaling interfaces.
+var (
+	_ encoding.TextMarshaler   = INN{}
+	_ encoding.TextUnmarshaler = (*INN)(nil)
+	_ json.Marshaler           = INN{}
+	_ json.Unmarshaler         = (*INN)(nil)
+	_ xml.Marshaler            = INN{}
+	_ xml.Unmarshaler          = (*INN)(nil)
+	_ xml.MarshalerAttr        = INN{}
+	_ xml.UnmarshalerAttr      = (*INN)(nil)
+	_ sql.Scanner              = (*INN)(nil)
+	_ driver.Valuer            = INN{}
+)
+
+// INN is a valid Taxpayer Identification Number, it can be created only by New or by decoding,
+// which reject invalid values. The zero value is an empty INN which is encoded as JSON null.
+type INN struct {
+	value string
+}
+
+// New validates the string and returns it as INN.
+func New(value string) (INN, error) {
+	validator := NewValidator(value, 0)
+	if err := validator.Validate(); err != nil {
+		return INN{}, err
+	}
+	return INN{value: validator.inn}, nil
+}
+
+// MustNew is like New but panics if the value is invalid.
+// It simplifies initialization of variables with known valid INNs.
+func MustNew(value string) INN {
+	n, err := New(value)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// String returns INN as a string, it is empty for the zero value.
+func (n INN) String() string {
+	return n.value
+}
+
+// IsZero returns true for the zero value.
+func (n INN) IsZero() bool {
+	return n.value == ""
+}
+
+// Kind returns a kind of INN, it is KindUnknown for the zero value.
+func (n INN) Kind() Kind {
+	return kindOfValid(n.value)
+}
+
+// Info returns a structured information about INN, it is nil for the zero value.
+func (n INN) Info() *Info {
+	if n.IsZero() {
+		return nil
+	}
+
+	info, err := Parse(n.value)
+	if err != nil {
+		return nil // unreachable, INN is always valid
+	}
+	return info
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n INN) MarshalText() ([]byte, error) {
+	return []byte(n.value), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it rejects invalid INNs.
+func (n *INN) UnmarshalText(text []byte) error {
+	value, err := New(string(text))
+	if err != nil {
+		return err
+	}
+
+	*n = value
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the zero value is encoded as null.
+func (n INN) MarshalJSON() ([]byte, error) {
+	if n.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts only JSON strings and null.
+func (n *INN) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = INN{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("INN must be a JSON string: %w", err)
+	}
+
+	return n.UnmarshalText([]byte(value))
+}
+
+// MarshalXML implements xml.Marshaler, the zero value is omitted.
+func (n INN) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if n.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(n.value, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler, it rejects invalid INNs.
+func (n *INN) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	return n.UnmarshalText([]byte(value))
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr, the zero value is omitted.
+func (n INN) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if n.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: n.value}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr, it rejects invalid INNs.
+func (n *INN) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.UnmarshalText([]byte(attr.Value))
+}
+
+// Scan implements sql.Scanner, NULL is scanned as the zero value and invalid INNs are rejected.
+func (n *INN) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*n = INN{}
+		return nil
+	case string:
+		return n.UnmarshalText([]byte(v))
+	case []byte:
+		return n.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into INN", src)
+	}
+}
+
+// Value implements driver.Valuer, the zero value is stored as NULL.
+func (n INN) Value() (driver.Value, error) {
+	if n.IsZero() {
+		return nil, nil
+	}
+	return n.value, nil
+}
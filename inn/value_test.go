@@ -0,0 +1,278 @@
+package inn
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		want     string
+		wantKind Kind
+		wantErr  error
+	}{
+		{name: "juridical", value: "7707083893", want: "7707083893", wantKind: KindJuridical},
+		{name: "physical with spaces", value: " 500100732259 ", want: "500100732259", wantKind: KindPhysical},
+		{name: "foreign", value: "9909123454", want: "9909123454", wantKind: KindForeign},
+		{name: "empty", value: "", wantErr: ErrInnLength},
+		{name: "format", value: "77070838A3", wantErr: ErrInnFormat},
+		{name: "checksum", value: "7707083892", wantErr: ErrInnChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := New(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if s := got.String(); s != tt.want {
+				t.Errorf("String() = %q, want %q", s, tt.want)
+			}
+
+			if k := got.Kind(); k != tt.wantKind {
+				t.Errorf("Kind() = %v, want %v", k, tt.wantKind)
+			}
+
+			if got.IsZero() != (tt.wantErr != nil) {
+				t.Errorf("IsZero() = %v, wantErr %v", got.IsZero(), tt.wantErr)
+			}
+
+			if info := got.Info(); (info == nil) != got.IsZero() {
+				t.Errorf("Info() = %v for INN %q", info, got)
+			}
+		})
+	}
+}
+
+func TestMustNew(t *testing.T) {
+	t.Parallel()
+
+	if got := MustNew("7707083893"); got.String() != "7707083893" {
+		t.Errorf("MustNew() = %q, want %q", got, "7707083893")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("MustNew() did not panic for invalid INN")
+		}
+	}()
+	_ = MustNew("7707083892")
+}
+
+type innDTO struct {
+	XMLName  xml.Name `json:"-" xml:"company"`
+	Code     INN      `json:"code" xml:"code,attr"`
+	INN      INN      `json:"inn" xml:"inn"`
+	Optional INN      `json:"optional,omitzero" xml:"optional,omitempty"`
+	Pointer  *INN     `json:"pointer,omitempty" xml:"pointer,omitempty"`
+}
+
+func TestINN_JSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		src := innDTO{Code: MustNew("7707083893"), INN: MustNew("500100732259")}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+
+		const want = `{"code":"7707083893","inn":"500100732259"}`
+		if string(data) != want {
+			t.Errorf("json.Marshal() = %s, want %s", data, want)
+		}
+
+		var dst innDTO
+		if err = json.Unmarshal(data, &dst); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+
+		if dst.Code != src.Code || dst.INN != src.INN || !dst.Optional.IsZero() || dst.Pointer != nil {
+			t.Errorf("json.Unmarshal() = %+v, want %+v", dst, src)
+		}
+	})
+
+	t.Run("zero value is null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(INN{})
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		if string(data) != "null" {
+			t.Errorf("json.Marshal() = %s, want null", data)
+		}
+
+		n := MustNew("7707083893")
+		if err = json.Unmarshal([]byte("null"), &n); err != nil || !n.IsZero() {
+			t.Errorf("json.Unmarshal(null) = %q, %v, want zero value", n, err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "checksum", data: `{"inn":"7707083892"}`, wantErr: ErrInnChecksum},
+		{name: "empty string", data: `{"inn":""}`, wantErr: ErrInnLength},
+		{name: "format", data: `{"inn":"77070838A3"}`, wantErr: ErrInnFormat},
+		{name: "pointer", data: `{"pointer":"7707083892"}`, wantErr: ErrInnChecksum},
+		{name: "number", data: `{"inn":7707083893}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var dst innDTO
+			err := json.Unmarshal([]byte(tt.data), &dst)
+			if err == nil {
+				t.Fatalf("json.Unmarshal() error = nil, want error")
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestINN_XML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		src := innDTO{Code: MustNew("7707083893"), INN: MustNew("500100732259")}
+		data, err := xml.Marshal(src)
+		if err != nil {
+			t.Fatalf("xml.Marshal() error = %v", err)
+		}
+
+		const want = `<company code="7707083893"><inn>500100732259</inn></company>`
+		if string(data) != want {
+			t.Errorf("xml.Marshal() = %s, want %s", data, want)
+		}
+
+		var dst innDTO
+		if err = xml.Unmarshal([]byte(`<company code="7707083893"><inn>500100732259</inn></company>`), &dst); err != nil {
+			t.Fatalf("xml.Unmarshal() error = %v", err)
+		}
+
+		if dst.Code != src.Code || dst.INN != src.INN {
+			t.Errorf("xml.Unmarshal() = %+v, want %+v", dst, src)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "element checksum", data: `<company><inn>7707083892</inn></company>`, wantErr: ErrInnChecksum},
+		{name: "attribute checksum", data: `<company code="7707083892"></company>`, wantErr: ErrInnChecksum},
+		{name: "empty element", data: `<company><inn></inn></company>`, wantErr: ErrInnLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var dst innDTO
+			if err := xml.Unmarshal([]byte(tt.data), &dst); !errors.Is(err, tt.wantErr) {
+				t.Errorf("xml.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestINN_Text(t *testing.T) {
+	t.Parallel()
+
+	n := MustNew("7707083893")
+	text, err := n.MarshalText()
+	if err != nil || string(text) != "7707083893" {
+		t.Fatalf("MarshalText() = %s, %v", text, err)
+	}
+
+	var dst INN
+	if err = dst.UnmarshalText(text); err != nil || dst != n {
+		t.Errorf("UnmarshalText() = %q, %v, want %q", dst, err, n)
+	}
+
+	if err = dst.UnmarshalText([]byte("7707083892")); !errors.Is(err, ErrInnChecksum) {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, ErrInnChecksum)
+	}
+
+	if dst != n {
+		t.Errorf("UnmarshalText() changed value on error: %q", dst)
+	}
+}
+
+func TestINN_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		src      any
+		want     string
+		wantErr  error
+		wantFail bool
+	}{
+		{name: "string", src: "7707083893", want: "7707083893"},
+		{name: "bytes", src: []byte("500100732259"), want: "500100732259"},
+		{name: "null", src: nil},
+		{name: "invalid checksum", src: "7707083892", wantErr: ErrInnChecksum},
+		{name: "integer", src: int64(7707083893), wantFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := MustNew("9909123454")
+			err := n.Scan(tt.src)
+
+			if tt.wantFail {
+				if err == nil {
+					t.Fatal("Scan() error = nil, want error")
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && n.String() != tt.want {
+				t.Errorf("Scan() = %q, want %q", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestINN_Value(t *testing.T) {
+	t.Parallel()
+
+	value, err := MustNew("7707083893").Value()
+	if err != nil || value != "7707083893" {
+		t.Errorf("Value() = %v, %v, want %q", value, err, "7707083893")
+	}
+
+	value, err = INN{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("Value() = %v, %v, want nil", value, err)
+	}
+}
@@ -2,17 +2,23 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"os"
 	"runtime"
 	"runtime/debug"
-
-	"github.com/z0rr0/inngen/inn"
 )
 
-const name = "INNGen"
+const (
+	name    = "INNGen"
+	binName = "inngen"
+
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
 
 var (
 	// Version is a git version.
@@ -25,13 +31,34 @@ var (
 	GoVersion = runtime.Version() //nolint:gochecknoglobals
 )
 
+var (
+	// errInvalid is returned by a command when some checked values are invalid, the results are already printed.
+	errInvalid = errors.New("invalid values found")
+	// errUsage is returned by a command for invalid flags or arguments, the usage is already printed.
+	errUsage = errors.New("invalid usage")
+)
+
+// command is a CLI subcommand.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands returns the CLI subcommands in the help order.
+func commands() []command {
+	return []command{
+		{name: "validate", summary: "check if INNs are valid", run: runValidate},
+		{name: "generate", summary: "generate INNs, organizations or persons", run: runGenerate},
+		{name: "check-csv", summary: "validate INN columns of a CSV file", run: runCheckCSV},
+		{name: "info", summary: "show information about INN", run: runInfo},
+		{name: "sql", summary: "print SQL check function and constraint", run: runSQL},
+		{name: "serve", summary: "run web application", run: runServe},
+		{name: "version", summary: "show version", run: runVersion},
+	}
+}
+
 func main() {
-	var (
-		checkINN     string
-		genPhysical  = 5
-		genJuridical = 5
-		runWeb       = "127.0.0.1:2288"
-	)
 	defer func() {
 		if r := recover(); r != nil {
 			slog.Error("abnormal termination", "version", Version, "error", r)
@@ -39,63 +66,58 @@ func main() {
 			if writeErr != nil {
 				slog.Error("failed to write stack trace", "error", writeErr)
 			}
+			os.Exit(exitFailure)
 		}
 	}()
-	flag.StringVar(&checkINN, "c", "", "check if INN is valid")
-	flag.StringVar(&runWeb, "w", runWeb, "run as web application")
-	flag.IntVar(&genPhysical, "f", genPhysical, "generate INNs for physical persons")
-	flag.IntVar(&genJuridical, "j", genJuridical, "generate INNs for juridical persons")
-	version := flag.Bool("v", false, "show version")
 
-	flag.Parse()
-	if *version {
-		fmt.Println(name + ": INN (Taxpayer Identification Number) Generator and Validator")
-		fmt.Printf(
-			"Version: %v\nRevision: %v\nBuild date: %v\nGo version: %v\n",
-			Version, Revision, BuildDate, GoVersion,
-		)
-		fmt.Println("\nUsage:")
-		flag.PrintDefaults()
-		return
+	os.Exit(run(os.Args[1:]))
+}
+
+// run executes the subcommand from the arguments and returns the exit code.
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		return exitUsage
 	}
 
-	if checkINN != "" {
-		validator := inn.NewValidator(checkINN, 0)
-		err := validator.Validate()
-		fmt.Println(inn.FmtResult(checkINN, err))
-		return
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		printUsage(os.Stdout)
+		return exitOK
+	case "-v", "-version", "--version":
+		args[0] = "version"
 	}
 
-	if genPhysical > 0 {
-		fmt.Printf("Generated %d INN(s) for physical persons:\n", genPhysical)
-		for i := range genPhysical {
-			value, err := inn.GeneratePhysicalINN()
-			if err != nil {
-				_, _ = fmt.Fprintf(os.Stderr, "Error generating INN: %v\n", err)
-				os.Exit(1) //nolint:gocritic
-			}
-			fmt.Printf("%-3d %s\n", i+1, value)
+	for _, cmd := range commands() {
+		if cmd.name != args[0] {
+			continue
 		}
-	}
 
-	if genJuridical > 0 {
-		fmt.Printf("Generated %d INN(s) for juridical persons:\n", genJuridical)
-		for i := range genJuridical {
-			value, err := inn.GenerateJuridicalINN()
-			if err != nil {
-				_, _ = fmt.Fprintf(os.Stderr, "Error generating INN: %v\n", err)
-				os.Exit(1)
-			}
-			fmt.Printf("%-3d %s\n", i+1, value)
+		err := cmd.run(args[1:])
+		switch {
+		case err == nil, errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.Is(err, errUsage):
+			return exitUsage
+		case errors.Is(err, errInvalid):
+			return exitFailure
+		default:
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitFailure
 		}
 	}
 
-	//  if *runWeb {
-	//	addr := "127.0.0.1:2288"
-	//	fmt.Printf("Starting web server on %s...\n", addr)
-	//	if err := startWebServer(addr); err != nil {
-	//		fmt.Fprintf(os.Stderr, "Error starting web server: %v\n", err)
-	//		os.Exit(1)
-	//	}
-	//  }
+	_, _ = fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", args[0])
+	printUsage(os.Stderr)
+	return exitUsage
+}
+
+// printUsage prints the list of subcommands.
+func printUsage(w *os.File) {
+	_, _ = fmt.Fprintf(w, "%s: INN (Taxpayer Identification Number) Generator and Validator\n\n", name)
+	_, _ = fmt.Fprintf(w, "Usage:\n  %s <command> [flags] [arguments]\n\nCommands:\n", binName)
+	for _, cmd := range commands() {
+		_, _ = fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	_, _ = fmt.Fprintf(w, "\nRun \"%s <command> -h\" for the command flags.\n", binName)
 }
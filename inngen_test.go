@@ -0,0 +1,108 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// discardOutput redirects stdout and stderr to the null device until the test ends.
+func discardOutput(t *testing.T) {
+	t.Helper()
+
+	null, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = null, null
+
+	t.Cleanup(func() {
+		os.Stdout, os.Stderr = stdout, stderr
+		_ = null.Close()
+	})
+}
+
+func TestRun(t *testing.T) {
+	discardOutput(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "no arguments", args: []string{}, want: exitUsage},
+		{name: "help", args: []string{"help"}, want: exitOK},
+		{name: "help flag", args: []string{"-h"}, want: exitOK},
+		{name: "long help flag", args: []string{"--help"}, want: exitOK},
+		{name: "version", args: []string{"version"}, want: exitOK},
+		{name: "version flag", args: []string{"-v"}, want: exitOK},
+		{name: "long version flag", args: []string{"--version"}, want: exitOK},
+		{name: "unknown command", args: []string{"check"}, want: exitUsage},
+		{name: "command help", args: []string{"validate", "-h"}, want: exitOK},
+		{name: "unknown flag", args: []string{"validate", "-x", "7707083893"}, want: exitUsage},
+		{name: "invalid flag value", args: []string{"generate", "-n", "many"}, want: exitUsage},
+		{name: "invalid flag range", args: []string{"generate", "-n", "0"}, want: exitUsage},
+		{name: "zero region", args: []string{"generate", "-region", "0"}, want: exitUsage},
+		{name: "region out of range", args: []string{"generate", "-region", "100"}, want: exitUsage},
+		{name: "office without region", args: []string{"generate", "-office", "7"}, want: exitUsage},
+		{name: "foreign prefix", args: []string{"generate", "-kind", "juridical", "-region", "99", "-office", "9"}, want: exitUsage},
+		{name: "missing arguments", args: []string{"validate"}, want: exitUsage},
+		{name: "extra arguments", args: []string{"info", "7707083893", "7707083893"}, want: exitUsage},
+		{name: "valid INN", args: []string{"validate", "7707083893"}, want: exitOK},
+		{name: "invalid INN", args: []string{"validate", "7707083893", "7707083892"}, want: exitFailure},
+		{name: "invalid INN info", args: []string{"info", "123"}, want: exitFailure},
+		{name: "generate", args: []string{"generate", "-seed", "1", "-n", "2", "-o", "json"}, want: exitOK},
+		{name: "command error", args: []string{"sql", "oracle"}, want: exitFailure},
+		{name: "missing file", args: []string{"validate", "-f", "testdata/missing.txt"}, want: exitFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.args); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Stdin(t *testing.T) {
+	discardOutput(t)
+
+	tests := []struct {
+		name  string
+		args  []string
+		input string
+		want  int
+	}{
+		{name: "dash argument", args: []string{"validate", "-"}, input: "7707083893\n", want: exitOK},
+		{name: "dash argument invalid", args: []string{"validate", "7707083893", "-"}, input: "7707083892\n", want: exitFailure},
+		{name: "dash file", args: []string{"validate", "-f", "-"}, input: "\n500100732259\n", want: exitOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "stdin.txt")
+			if err := os.WriteFile(path, []byte(tt.input), 0o600); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+
+			stdin := os.Stdin
+			os.Stdin = f
+			defer func() {
+				os.Stdin = stdin
+				_ = f.Close()
+			}()
+
+			if got := run(tt.args); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
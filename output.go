@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+// outputFormat is a format of the command output.
+type outputFormat string
+
+// Output formats, plain is a human-readable text.
+const (
+	formatPlain  outputFormat = "plain"
+	formatJSON   outputFormat = "json"
+	formatNDJSON outputFormat = "ndjson"
+	formatCSV    outputFormat = "csv"
+
+	// errorKindUnknown is an error kind of errors without inn.ErrorKind.
+	errorKindUnknown = "unknown"
+)
+
+// CSV headers of output records.
+var (
+	resultHeader       = []string{"inn", "kind", "valid", "error_kind", "error"}                   //nolint:gochecknoglobals
+	sourceHeader       = []string{"inn", "kind", "valid", "error_kind", "error", "source", "line"} //nolint:gochecknoglobals
+	organizationHeader = []string{"inn", "kpp", "ogrn", "okpo", "legal_form", "name", "address"}   //nolint:gochecknoglobals
+	personHeader       = []string{                                                                 //nolint:gochecknoglobals
+		"inn", "snils", "last_name", "first_name", "patronymic", "gender", "birth_date", "ogrnip", "address",
+	}
+)
+
+// errOutputFormat is an error for unknown output formats.
+var errOutputFormat = errors.New("unknown output format")
+
+// parseOutputFormat returns an output format by its name.
+func parseOutputFormat(name string) (outputFormat, error) {
+	switch f := outputFormat(strings.ToLower(strings.TrimSpace(name))); f {
+	case formatPlain, formatJSON, formatNDJSON, formatCSV:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected plain, json, ndjson or csv", errOutputFormat, name)
+	}
+}
+
+// result is a machine-readable validation result of a single INN,
+// source and line are set for INNs read from files or stdin.
+type result struct {
+	INN       string   `json:"inn"`
+	Kind      inn.Kind `json:"kind,omitempty"`
+	Valid     bool     `json:"valid"`
+	ErrorKind string   `json:"error_kind,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	Source    string   `json:"source,omitempty"`
+	Line      int      `json:"line,omitempty"`
+}
+
+// newResult builds a result for the INN value and its validation error.
+func newResult(value string, err error) *result {
+	value = strings.TrimSpace(value)
+	r := &result{INN: value, Kind: inn.KindOf(value), Valid: err == nil}
+
+	if err != nil {
+		r.ErrorKind, r.Error = errorKind(err), err.Error()
+		return r
+	}
+
+	if info, parseErr := inn.Parse(value); parseErr == nil {
+		r.Kind = info.Kind
+	}
+	return r
+}
+
+// row returns CSV fields of the result, withSource adds the source and line fields.
+func (r *result) row(withSource bool) []string {
+	fields := []string{r.INN, string(r.Kind), strconv.FormatBool(r.Valid), r.ErrorKind, r.Error}
+	if withSource {
+		line := ""
+		if r.Line > 0 {
+			line = strconv.Itoa(r.Line)
+		}
+		fields = append(fields, r.Source, line)
+	}
+	return fields
+}
+
+// errorKind returns a machine-readable kind of the validation error.
+func errorKind(err error) string {
+	if kind := inn.ErrorKindOf(err); kind != "" {
+		return string(kind)
+	}
+	return errorKindUnknown
+}
+
+// organizationRow returns CSV fields of the organization.
+func organizationRow(org *inn.Organization) []string {
+	return []string{org.INN, org.KPP, org.OGRN, org.OKPO, org.LegalForm.Short, org.Name, addressString(org.Address)}
+}
+
+// personRow returns CSV fields of the physical person.
+func personRow(p *inn.Person) []string {
+	return []string{
+		p.INN, p.SNILS, p.LastName, p.FirstName, p.Patronymic, string(p.Gender),
+		p.BirthDate.Format(time.DateOnly), p.OGRNIP, addressString(p.Address),
+	}
+}
+
+// addressString returns the address as a single line or an empty string for nil.
+func addressString(address *inn.Address) string {
+	if address == nil {
+		return ""
+	}
+	return address.String()
+}
+
+// encoder writes records in a machine-readable output format,
+// JSON records are streamed as an array, so large outputs are not kept in memory.
+type encoder struct {
+	format outputFormat
+	out    *bufio.Writer
+	csv    *csv.Writer
+	header []string
+	count  int
+}
+
+// newEncoder returns an encoder of the format, the header is used only for CSV.
+func newEncoder(w io.Writer, format outputFormat, header []string) *encoder {
+	e := &encoder{format: format, out: bufio.NewWriter(w), header: header}
+	if format == formatCSV {
+		e.csv = csv.NewWriter(e.out)
+	}
+	return e
+}
+
+// encode writes the record, item is used for JSON formats and row for CSV.
+func (e *encoder) encode(item any, row []string) error {
+	defer func() { e.count++ }()
+
+	switch e.format {
+	case formatCSV:
+		if e.count == 0 {
+			if err := e.csv.Write(e.header); err != nil {
+				return err
+			}
+		}
+		return e.csv.Write(row)
+	case formatNDJSON:
+		return json.NewEncoder(e.out).Encode(item)
+	default:
+		data, err := json.MarshalIndent(item, "  ", "  ")
+		if err != nil {
+			return err
+		}
+
+		prefix := ",\n  "
+		if e.count == 0 {
+			prefix = "[\n  "
+		}
+
+		if _, err = e.out.WriteString(prefix); err != nil {
+			return err
+		}
+		_, err = e.out.Write(data)
+		return err
+	}
+}
+
+// text writes a line of the plain output.
+func (e *encoder) text(line string) error {
+	if _, err := e.out.WriteString(line); err != nil {
+		return err
+	}
+	return e.out.WriteByte('\n')
+}
+
+// close finishes the output and flushes it.
+func (e *encoder) close() error {
+	switch e.format {
+	case formatCSV:
+		if e.count == 0 {
+			if err := e.csv.Write(e.header); err != nil {
+				return err
+			}
+		}
+
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	case formatJSON:
+		end := "\n]\n"
+		if e.count == 0 {
+			end = "[]\n"
+		}
+
+		if _, err := e.out.WriteString(end); err != nil {
+			return err
+		}
+	default:
+	}
+
+	return e.out.Flush()
+}
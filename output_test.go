@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		want    outputFormat
+		wantErr error
+	}{
+		{name: "plain", want: formatPlain},
+		{name: " JSON ", want: formatJSON},
+		{name: "ndjson", want: formatNDJSON},
+		{name: "csv", want: formatCSV},
+		{name: "xml", wantErr: errOutputFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseOutputFormat(tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("parseOutputFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseOutputFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	t.Parallel()
+
+	results := []*result{
+		newResult("7707083893", nil),
+		newResult("7707083892", inn.NewValidator("7707083892", 0).Validate()),
+	}
+
+	tests := []struct {
+		name    string
+		format  outputFormat
+		results []*result
+		want    string
+	}{
+		{
+			name:    "json",
+			format:  formatJSON,
+			results: results,
+			want: "[\n" +
+				"  {\n    \"inn\": \"7707083893\",\n    \"kind\": \"juridical\",\n    \"valid\": true\n  },\n" +
+				"  {\n    \"inn\": \"7707083892\",\n    \"kind\": \"juridical\",\n    \"valid\": false,\n" +
+				"    \"error_kind\": \"checksum\",\n" +
+				"    \"error\": \"invalid INN checksum: invalid juridical inn, expected 3, got 2\"\n  }\n" +
+				"]\n",
+		},
+		{
+			name:   "empty json",
+			format: formatJSON,
+			want:   "[]\n",
+		},
+		{
+			name:    "ndjson",
+			format:  formatNDJSON,
+			results: results,
+			want: `{"inn":"7707083893","kind":"juridical","valid":true}` + "\n" +
+				`{"inn":"7707083892","kind":"juridical","valid":false,"error_kind":"checksum",` +
+				`"error":"invalid INN checksum: invalid juridical inn, expected 3, got 2"}` + "\n",
+		},
+		{
+			name:   "empty ndjson",
+			format: formatNDJSON,
+			want:   "",
+		},
+		{
+			name:    "csv",
+			format:  formatCSV,
+			results: results,
+			want: "inn,kind,valid,error_kind,error\n" +
+				"7707083893,juridical,true,,\n" +
+				"7707083892,juridical,false,checksum,\"invalid INN checksum: invalid juridical inn, expected 3, got 2\"\n",
+		},
+		{
+			name:   "empty csv",
+			format: formatCSV,
+			want:   "inn,kind,valid,error_kind,error\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			enc := newEncoder(&buf, tt.format, resultHeader)
+
+			for _, r := range tt.results {
+				if err := enc.encode(r, r.row(false)); err != nil {
+					t.Fatalf("encode() error = %v", err)
+				}
+			}
+
+			if err := enc.close(); err != nil {
+				t.Fatalf("close() error = %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("encoder output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Row(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		result     result
+		withSource bool
+		want       []string
+	}{
+		{
+			name:   "without source",
+			result: result{INN: "7707083893", Kind: inn.KindJuridical, Valid: true},
+			want:   []string{"7707083893", "juridical", "true", "", ""},
+		},
+		{
+			name:       "argument with source header",
+			result:     result{INN: "7707083893", Kind: inn.KindJuridical, Valid: true},
+			withSource: true,
+			want:       []string{"7707083893", "juridical", "true", "", "", "", ""},
+		},
+		{
+			name:       "file line",
+			result:     result{INN: "123", ErrorKind: "length", Error: "bad", Source: "inns.txt", Line: 3},
+			withSource: true,
+			want:       []string{"123", "", "false", "length", "bad", "inns.txt", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.result.row(tt.withSource); !slices.Equal(got, tt.want) {
+				t.Errorf("row() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+// printSQL prints SQL check function and constraint template for the dialect.
+func printSQL(dialectName, column string) error {
+	dialect, err := inn.ParseDialect(dialectName)
+	if err != nil {
+		return err
+	}
+
+	query, err := inn.GenerateSQL(dialect, column)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(query)
+	return nil
+}
+
+// resultText returns the human-readable validation result with suggestions for invalid INNs.
+func resultText(value string, err error) string {
+	var b strings.Builder
+	b.WriteString(inn.FmtResult(value, err))
+
+	if err == nil {
+		return b.String()
+	}
+
+	suggestions := inn.Suggest(value)
+	if len(suggestions) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("\nDid you mean:")
+	for _, s := range suggestions {
+		fmt.Fprintf(&b, "\n  %s (%s at position %d)", s.INN, s.Reason, s.Position)
+	}
+	return b.String()
+}
+
+// printInfo prints the INN information.
+func printInfo(info *inn.Info) {
+	fmt.Printf("INN:        %s\n", info.INN)
+	fmt.Printf("Kind:       %s\n", info.Kind)
+	fmt.Printf("Region:     %s %s\n", info.Region, info.RegionName)
+	fmt.Printf("Tax office: %s %s\n", info.TaxOffice, info.TaxOfficeName)
+	fmt.Printf("Serial:     %s\n", info.Serial)
+	if info.KIO != "" {
+		fmt.Printf("KIO:        %s\n", info.KIO)
+	}
+	fmt.Printf("Check:      %s\n", info.Check)
+}
+
+// printOrganization prints the generated organization requisites.
+func printOrganization(n int, org *inn.Organization) {
+	fmt.Printf("Organization %d:\n", n)
+	fmt.Printf("  Name:      %s\n", org.Name)
+	fmt.Printf("  Full name: %s\n", org.FullName)
+	fmt.Printf("  INN:       %s\n", org.INN)
+	fmt.Printf("  KPP:       %s\n", org.KPP)
+	fmt.Printf("  OGRN:      %s\n", org.OGRN)
+	fmt.Printf("  OKPO:      %s\n", org.OKPO)
+	if org.Address != nil {
+		fmt.Printf("  Address:   %s\n", org.Address)
+	}
+}
+
+// printPerson prints the generated physical person data.
+func printPerson(n int, person *inn.Person) {
+	fmt.Printf("Person %d:\n", n)
+	fmt.Printf("  Name:       %s\n", person.FullName())
+	fmt.Printf("  Gender:     %s\n", person.Gender)
+	fmt.Printf("  Birth date: %s\n", person.BirthDate.Format(time.DateOnly))
+	fmt.Printf("  INN:        %s\n", person.INN)
+	if snils, err := inn.FormatSNILS(person.SNILS); err == nil {
+		fmt.Printf("  SNILS:      %s\n", snils)
+	}
+	if person.OGRNIP != "" {
+		fmt.Printf("  OGRNIP:     %s\n", person.OGRNIP)
+	}
+	if person.Address != nil {
+		fmt.Printf("  Address:    %s\n", person.Address)
+	}
+}
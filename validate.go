@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+const (
+	// stdinPath is a file path to read INNs from stdin.
+	stdinPath = "-"
+	// stdinSource is a source name of INNs read from stdin.
+	stdinSource = "stdin"
+	// byteOrderMark is a UTF-8 BOM which can start files exported on Windows.
+	byteOrderMark = "\ufeff"
+)
+
+// filesFlag is a repeatable command line flag of file paths.
+type filesFlag []string
+
+// String returns the comma-separated file paths.
+func (f *filesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(*f, ",")
+}
+
+// Set adds the file path.
+func (f *filesFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// validation checks INNs and writes per-INN results in the output format.
+type validation struct {
+	strict     bool
+	withSource bool
+	format     outputFormat
+	enc        *encoder
+	valid      int
+	invalid    int
+}
+
+// check validates the INN and writes its result, source and line are empty for command line arguments.
+func (v *validation) check(value, source string, line int) error {
+	err := validateINN(value, v.strict)
+	if err != nil {
+		v.invalid++
+	} else {
+		v.valid++
+	}
+
+	if v.format == formatPlain {
+		if !v.withSource {
+			return v.enc.text(resultText(value, err))
+		}
+
+		// bulk results are one per line without suggestions, so they can be filtered by line tools
+		text := inn.FmtResult(value, err)
+		if source != "" {
+			text = fmt.Sprintf("%s:%d: %s", source, line, text)
+		}
+		return v.enc.text(text)
+	}
+
+	r := newResult(value, err)
+	r.Source, r.Line = source, line
+	return v.enc.encode(r, r.row(v.withSource))
+}
+
+// checkFile validates INNs from the file, one per line, empty lines are skipped.
+func (v *validation) checkFile(path string) error {
+	if path == stdinPath {
+		return v.checkReader(os.Stdin, stdinSource)
+	}
+
+	f, err := os.Open(path) //nolint:gosec // the path is set by the user
+	if err != nil {
+		return err
+	}
+
+	err = v.checkReader(f, path)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// checkReader validates INNs from the reader, one per line, empty lines are skipped.
+func (v *validation) checkReader(r io.Reader, source string) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		value := strings.TrimSpace(scanner.Text())
+		if n == 1 {
+			value = strings.TrimPrefix(value, byteOrderMark)
+		}
+
+		if value == "" {
+			continue
+		}
+
+		if err := v.check(value, source, n); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading %s: %w", source, err)
+	}
+	return nil
+}
+
+// summary returns the numbers of checked, valid and invalid INNs.
+func (v *validation) summary() string {
+	return checkedSummary(v.valid, v.invalid)
+}
+
+// checkedSummary returns a human-readable summary of checked INNs.
+func checkedSummary(valid, invalid int) string {
+	return fmt.Sprintf("Checked %d INN(s): %d valid, %d invalid", valid+invalid, valid, invalid)
+}
+
+// runValidate checks INNs from the arguments, files and stdin.
+func runValidate(args []string) error {
+	var files filesFlag
+
+	fs := newFlagSet(
+		"validate", "[INN...]",
+		"Checks if INNs are valid, valid INNs are suggested for typos.\n"+
+			"INNs are read from the arguments and from files set by -f, one per line,\n"+
+			"argument \"-\" is the same as -f - and reads INNs from stdin.",
+	)
+	strict := fs.Bool("strict", false, "reject tax office codes which do not exist in regions with complete registry lists")
+	output := fs.String("o", string(formatPlain), "output format: plain, json, ndjson or csv")
+	fs.Var(&files, "f", "file with INNs, one per line, \"-\" is stdin, it can be repeated")
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	format, err := parseOutputFormat(*output)
+	if err != nil {
+		return usageError(fs, "%v", err)
+	}
+
+	var values []string
+	for _, value := range fs.Args() {
+		if value == stdinPath {
+			files = append(files, stdinPath)
+			continue
+		}
+		values = append(values, value)
+	}
+
+	if len(values) == 0 && len(files) == 0 {
+		return usageError(fs, "no INN to validate")
+	}
+
+	header, withSource := resultHeader, len(files) > 0
+	if withSource {
+		header = sourceHeader
+	}
+
+	v := &validation{
+		strict:     *strict,
+		withSource: withSource,
+		format:     format,
+		enc:        newEncoder(os.Stdout, format, header),
+	}
+
+	for _, value := range values {
+		if err = v.check(value, "", 0); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range files {
+		if err = v.checkFile(path); err != nil {
+			_ = v.enc.close()
+			return err
+		}
+	}
+
+	if withSource && format == formatPlain {
+		if err = v.enc.text(v.summary()); err != nil {
+			return err
+		}
+	}
+
+	if err = v.enc.close(); err != nil {
+		return err
+	}
+
+	if withSource && format != formatPlain {
+		// keep machine-readable stdout clean, the summary is for humans
+		_, _ = fmt.Fprintln(os.Stderr, v.summary())
+	}
+
+	if v.invalid > 0 {
+		return errInvalid
+	}
+	return nil
+}
+
+// validateINN checks the INN, strict mode also rejects tax office codes which do not exist.
+func validateINN(value string, strict bool) error {
+	validator := inn.NewValidator(value, 0)
+	if strict {
+		return validator.ValidateStrict()
+	}
+	return validator.Validate()
+}
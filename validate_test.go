@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestValidation_CheckReader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		wantValid   int
+		wantInvalid int
+	}{
+		{
+			name:      "byte order mark",
+			input:     byteOrderMark + "7707083893\n",
+			want:      "inns.txt:1: INN 7707083893 is valid (juridical person)\n",
+			wantValid: 1,
+		},
+		{
+			name:  "blank lines keep line numbers",
+			input: "\n7707083893\n  \n\n123\n",
+			want: "inns.txt:2: INN 7707083893 is valid (juridical person)\n" +
+				"inns.txt:5: INN 123 invalid: invalid INN length: valid required lengths are 12 or 10, got 3\n",
+			wantValid:   1,
+			wantInvalid: 1,
+		},
+		{
+			name:        "checksum error without suggestions",
+			input:       "7707083892\n",
+			want:        "inns.txt:1: INN 7707083892 invalid: invalid INN checksum: invalid juridical inn, expected 3, got 2\n",
+			wantInvalid: 1,
+		},
+		{
+			name:      "windows line endings and spaces",
+			input:     " 7707083893 \r\n500100732259\r\n",
+			want:      "inns.txt:1: INN 7707083893 is valid (juridical person)\ninns.txt:2: INN 500100732259 is valid (physical person)\n",
+			wantValid: 2,
+		},
+		{
+			name:      "last line without newline",
+			input:     "7707083893",
+			want:      "inns.txt:1: INN 7707083893 is valid (juridical person)\n",
+			wantValid: 1,
+		},
+		{
+			name:  "empty",
+			input: "",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			v := &validation{withSource: true, format: formatPlain, enc: newEncoder(&buf, formatPlain, sourceHeader)}
+
+			if err := v.checkReader(strings.NewReader(tt.input), "inns.txt"); err != nil {
+				t.Fatalf("checkReader() error = %v", err)
+			}
+
+			if err := v.enc.close(); err != nil {
+				t.Fatalf("close() error = %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("checkReader() output = %q, want %q", got, tt.want)
+			}
+
+			if v.valid != tt.wantValid || v.invalid != tt.wantInvalid {
+				t.Errorf("checkReader() valid = %d, invalid = %d, want %d and %d", v.valid, v.invalid, tt.wantValid, tt.wantInvalid)
+			}
+		})
+	}
+}
+
+func TestValidation_CheckReaderCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	v := &validation{withSource: true, format: formatCSV, enc: newEncoder(&buf, formatCSV, sourceHeader)}
+
+	if err := v.checkReader(strings.NewReader(byteOrderMark+"7707083893\n\n7707083892\n"), "inns.txt"); err != nil {
+		t.Fatalf("checkReader() error = %v", err)
+	}
+
+	if err := v.enc.close(); err != nil {
+		t.Fatalf("close() error = %v", err)
+	}
+
+	want := "inn,kind,valid,error_kind,error,source,line\n" +
+		"7707083893,juridical,true,,,inns.txt,1\n" +
+		"7707083892,juridical,false,checksum,\"invalid INN checksum: invalid juridical inn, expected 3, got 2\",inns.txt,3\n"
+	if got := buf.String(); got != want {
+		t.Errorf("checkReader() output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,141 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+// API error kinds, validation errors also use inn.ErrorKind values.
+const (
+	errorKindLength    = string(inn.ErrorKindLength)
+	errorKindFormat    = string(inn.ErrorKindFormat)
+	errorKindChecksum  = string(inn.ErrorKindChecksum)
+	errorKindTaxOffice = string(inn.ErrorKindTaxOffice)
+	errorKindRequest   = "request"
+	errorKindInternal  = "internal"
+)
+
+// innResult is an API representation of a single INN.
+type innResult struct {
+	INN       string   `json:"inn"`
+	Kind      inn.Kind `json:"kind,omitempty"`
+	Valid     bool     `json:"valid"`
+	ErrorKind string   `json:"error_kind,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	Position  int      `json:"position,omitempty"`
+	Expected  string   `json:"expected,omitempty"`
+	Actual    string   `json:"actual,omitempty"`
+
+	Suggestions []inn.Suggestion `json:"suggestions,omitempty"`
+}
+
+// generateResult is an API response for INN generation.
+type generateResult struct {
+	Kind  inn.Kind    `json:"kind"`
+	Count int         `json:"count"`
+	Items []innResult `json:"items"`
+}
+
+// errorResult is an API response for failed requests.
+type errorResult struct {
+	ErrorKind string `json:"error_kind"`
+	Error     string `json:"error"`
+}
+
+func (s *Server) registerAPI(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/validate", s.handleAPIValidate)
+	mux.HandleFunc("GET /api/v1/info", s.handleAPIInfo)
+	mux.HandleFunc("GET /api/v1/generate/physical", s.handleAPIGenerate(inn.KindPhysical))
+	mux.HandleFunc("GET /api/v1/generate/juridical", s.handleAPIGenerate(inn.KindJuridical))
+}
+
+func (s *Server) handleAPIValidate(w http.ResponseWriter, r *http.Request) {
+	value := strings.TrimSpace(r.URL.Query().Get("inn"))
+	if value == "" {
+		s.writeJSON(w, http.StatusBadRequest, &errorResult{ErrorKind: errorKindRequest, Error: "parameter 'inn' is required"})
+		return
+	}
+
+	err := validate(value, isStrict(r))
+	s.writeJSON(w, http.StatusOK, newINNResult(value, err))
+}
+
+func (s *Server) handleAPIInfo(w http.ResponseWriter, r *http.Request) {
+	value := strings.TrimSpace(r.URL.Query().Get("inn"))
+	if value == "" {
+		s.writeJSON(w, http.StatusBadRequest, &errorResult{ErrorKind: errorKindRequest, Error: "parameter 'inn' is required"})
+		return
+	}
+
+	info, err := inn.Parse(value)
+	if err != nil {
+		s.writeJSON(w, http.StatusUnprocessableEntity, &errorResult{ErrorKind: validationErrorKind(err), Error: err.Error()})
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, info)
+}
+
+func (s *Server) handleAPIGenerate(kind inn.Kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values, err := generateINNs(r.URL.Query(), kind)
+		if err != nil {
+			if isRequestError(err) {
+				s.writeJSON(w, http.StatusBadRequest, &errorResult{ErrorKind: errorKindRequest, Error: err.Error()})
+				return
+			}
+
+			s.logger.Error("failed to generate INN", "kind", kind, "error", err)
+			s.writeJSON(w, http.StatusInternalServerError, &errorResult{ErrorKind: errorKindInternal, Error: "failed to generate INN"})
+			return
+		}
+
+		result := &generateResult{Kind: kind, Count: len(values), Items: make([]innResult, 0, len(values))}
+		for _, value := range values {
+			result.Items = append(result.Items, innResult{INN: value, Kind: kind, Valid: true})
+		}
+
+		s.writeJSON(w, http.StatusOK, result)
+	}
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, code int, data any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		s.logger.Error("failed to write JSON response", "error", err)
+	}
+}
+
+// newINNResult builds an API result for the INN value and its validation error.
+func newINNResult(value string, err error) innResult {
+	result := innResult{INN: value, Kind: inn.KindOf(value), Valid: err == nil}
+	if err != nil {
+		result.ErrorKind = validationErrorKind(err)
+		result.Error = err.Error()
+
+		if vErr, ok := inn.AsValidationError(err); ok {
+			result.Position, result.Expected, result.Actual = vErr.Position, vErr.Expected, vErr.Actual
+			result.Suggestions = inn.Suggest(value)
+		}
+		return result
+	}
+
+	// the length based kind does not detect foreign organizations
+	if info, parseErr := inn.Parse(value); parseErr == nil {
+		result.Kind = info.Kind
+	}
+	return result
+}
+
+// validationErrorKind returns a machine-readable kind of the validation error.
+func validationErrorKind(err error) string {
+	if kind := inn.ErrorKindOf(err); kind != "" {
+		return string(kind)
+	}
+	return errorKindInternal
+}
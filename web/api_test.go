@@ -0,0 +1,273 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+func TestServer_HandleAPIValidate(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		want     innResult
+	}{
+		{
+			name:     "valid juridical INN",
+			target:   "/api/v1/validate?inn=7707083893",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "7707083893", Kind: inn.KindJuridical, Valid: true},
+		},
+		{
+			name:     "valid foreign organization INN",
+			target:   "/api/v1/validate?inn=9909348521",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "9909348521", Kind: inn.KindForeign, Valid: true},
+		},
+		{
+			name:     "valid physical INN with spaces",
+			target:   "/api/v1/validate?inn=%20500100732259%20",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "500100732259", Kind: inn.KindPhysical, Valid: true},
+		},
+		{
+			name:     "checksum error",
+			target:   "/api/v1/validate?inn=7707083892",
+			wantCode: http.StatusOK,
+			want: innResult{
+				INN: "7707083892", Kind: inn.KindJuridical, ErrorKind: errorKindChecksum,
+				Position: 10, Expected: "3", Actual: "2",
+			},
+		},
+		{
+			name:     "format error",
+			target:   "/api/v1/validate?inn=77070838A3",
+			wantCode: http.StatusOK,
+			want: innResult{
+				INN: "77070838A3", Kind: inn.KindJuridical, ErrorKind: errorKindFormat,
+				Position: 9, Expected: "0-9", Actual: "A",
+			},
+		},
+		{
+			name:     "strict valid",
+			target:   "/api/v1/validate?inn=7707083893&strict=true",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "7707083893", Kind: inn.KindJuridical, Valid: true},
+		},
+		{
+			name:     "strict unknown tax office",
+			target:   "/api/v1/validate?inn=7799000012&strict=1",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "7799000012", Kind: inn.KindJuridical, ErrorKind: errorKindTaxOffice},
+		},
+		{
+			name:     "not strict unknown tax office",
+			target:   "/api/v1/validate?inn=7799000012",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "7799000012", Kind: inn.KindJuridical, Valid: true},
+		},
+		{
+			name:     "length error",
+			target:   "/api/v1/validate?inn=123",
+			wantCode: http.StatusOK,
+			want:     innResult{INN: "123", ErrorKind: errorKindLength, Expected: "12 or 10", Actual: "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, http.MethodGet, tt.target)
+			if code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", code, tt.wantCode)
+			}
+
+			var got innResult
+			if err := json.Unmarshal([]byte(body), &got); err != nil {
+				t.Fatalf("failed to decode response %q: %v", body, err)
+			}
+
+			if (got.Error == "") != got.Valid {
+				t.Errorf("error message = %q for valid=%v", got.Error, got.Valid)
+			}
+
+			if hasSuggestions := len(got.Suggestions) > 0; hasSuggestions != (got.ErrorKind == errorKindChecksum) {
+				t.Errorf("suggestions = %v for error kind %q", got.Suggestions, got.ErrorKind)
+			}
+
+			got.Error, got.Suggestions = "", nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("response = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing parameter", func(t *testing.T) {
+		t.Parallel()
+
+		code, body := doRequest(t, handler, http.MethodGet, "/api/v1/validate")
+		if code != http.StatusBadRequest {
+			t.Fatalf("status code = %d, want %d", code, http.StatusBadRequest)
+		}
+
+		var got errorResult
+		if err := json.Unmarshal([]byte(body), &got); err != nil {
+			t.Fatalf("failed to decode response %q: %v", body, err)
+		}
+
+		if got.ErrorKind != errorKindRequest {
+			t.Errorf("error kind = %q, want %q", got.ErrorKind, errorKindRequest)
+		}
+	})
+}
+
+func TestServer_HandleAPIGenerate(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		kind     inn.Kind
+		count    int
+		length   int
+	}{
+		{
+			name:     "physical default count",
+			target:   "/api/v1/generate/physical",
+			wantCode: http.StatusOK,
+			kind:     inn.KindPhysical,
+			count:    DefaultCount,
+			length:   inn.PhysicalLength,
+		},
+		{
+			name:     "juridical custom count",
+			target:   "/api/v1/generate/juridical?count=7",
+			wantCode: http.StatusOK,
+			kind:     inn.KindJuridical,
+			count:    7,
+			length:   inn.JuridicalLength,
+		},
+		{
+			name:     "invalid count",
+			target:   "/api/v1/generate/juridical?count=0",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, http.MethodGet, tt.target)
+			if code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", code, tt.wantCode)
+			}
+
+			if code != http.StatusOK {
+				return
+			}
+
+			var got generateResult
+			if err := json.Unmarshal([]byte(body), &got); err != nil {
+				t.Fatalf("failed to decode response %q: %v", body, err)
+			}
+
+			if got.Kind != tt.kind || got.Count != tt.count || len(got.Items) != tt.count {
+				t.Fatalf("response kind=%q count=%d items=%d, want kind=%q count=%d", got.Kind, got.Count, len(got.Items), tt.kind, tt.count)
+			}
+
+			for _, item := range got.Items {
+				if !item.Valid || item.Kind != tt.kind {
+					t.Errorf("item = %+v, want valid %s INN", item, tt.kind)
+				}
+				if err := inn.NewValidator(item.INN, tt.length).Validate(); err != nil {
+					t.Errorf("generated INN %s is invalid: %v", item.INN, err)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_HandleAPIInfo(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+
+	tests := []struct {
+		name          string
+		target        string
+		wantCode      int
+		want          inn.Info
+		wantErrorKind string
+	}{
+		{
+			name:     "juridical",
+			target:   "/api/v1/info?inn=7707083893",
+			wantCode: http.StatusOK,
+			want: inn.Info{
+				INN: "7707083893", Kind: inn.KindJuridical, Region: "77", RegionName: "г. Москва",
+				TaxOffice: "7707", TaxOfficeName: "ИФНС России № 7 по г. Москве", Serial: "08389", Check: "3",
+			},
+		},
+		{
+			name:     "physical",
+			target:   "/api/v1/info?inn=500100732259",
+			wantCode: http.StatusOK,
+			want: inn.Info{
+				INN: "500100732259", Kind: inn.KindPhysical, Region: "50", RegionName: "Московская область",
+				TaxOffice: "5001", Serial: "007322", Check: "59",
+			},
+		},
+		{
+			name:          "invalid checksum",
+			target:        "/api/v1/info?inn=7707083892",
+			wantCode:      http.StatusUnprocessableEntity,
+			wantErrorKind: errorKindChecksum,
+		},
+		{
+			name:          "missing parameter",
+			target:        "/api/v1/info",
+			wantCode:      http.StatusBadRequest,
+			wantErrorKind: errorKindRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, http.MethodGet, tt.target)
+			if code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", code, tt.wantCode)
+			}
+
+			if tt.wantErrorKind != "" {
+				var got errorResult
+				if err := json.Unmarshal([]byte(body), &got); err != nil {
+					t.Fatalf("failed to decode response %q: %v", body, err)
+				}
+				if got.ErrorKind != tt.wantErrorKind {
+					t.Errorf("error kind = %q, want %q", got.ErrorKind, tt.wantErrorKind)
+				}
+				return
+			}
+
+			var got inn.Info
+			if err := json.Unmarshal([]byte(body), &got); err != nil {
+				t.Fatalf("failed to decode response %q: %v", body, err)
+			}
+			if got != tt.want {
+				t.Errorf("response = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,2 +1,321 @@
 // Package web provides web services.
 package web
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+const (
+	// DefaultAddr is the default address for the web server.
+	DefaultAddr = "127.0.0.1:2288"
+	// DefaultCount is the default number of INNs to generate.
+	DefaultCount = 5
+	// MaxCount is the maximum number of INNs to generate per request.
+	MaxCount = 100
+	// maxCode is the maximum value of region and tax office codes.
+	maxCode = 99
+
+	readHeaderTimeout = 5 * time.Second
+	readTimeout       = 10 * time.Second
+	writeTimeout      = 10 * time.Second
+	idleTimeout       = 60 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+var (
+	//go:embed templates/*.html
+	templatesFS embed.FS
+
+	// ErrInvalidCount is an error indicating an invalid count of INNs to generate.
+	ErrInvalidCount = errors.New("invalid count")
+	// ErrInvalidCode is an error indicating an invalid region or tax office code.
+	ErrInvalidCode = errors.New("invalid code")
+)
+
+// highlight is an INN split around the invalid character.
+type highlight struct {
+	Before string
+	Char   string
+	After  string
+}
+
+// newHighlight splits the value around the character at the 1-based position.
+func newHighlight(value string, position int) *highlight {
+	runes := []rune(value)
+	if position < 1 || position > len(runes) {
+		return nil
+	}
+
+	return &highlight{
+		Before: string(runes[:position-1]),
+		Char:   string(runes[position-1]),
+		After:  string(runes[position:]),
+	}
+}
+
+// pageData is a data for the index page template.
+type pageData struct {
+	INN         string
+	Result      string
+	Valid       bool
+	Checked     bool
+	Strict      bool
+	Info        *inn.Info
+	Highlight   *highlight
+	Suggestions []inn.Suggestion
+	Kind        inn.Kind
+	Count       int
+	Region      string
+	Office      string
+	Generated   []string
+	Error       string
+	MaxCount    int
+}
+
+// Server is a web server for INN validation and generation.
+type Server struct {
+	server *http.Server
+	tpl    *template.Template
+	logger *slog.Logger
+}
+
+// NewServer creates a new web server instance listening on the given address.
+func NewServer(addr string, logger *slog.Logger) (*Server, error) {
+	tpl, err := template.ParseFS(templatesFS, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	s := &Server{tpl: tpl, logger: logger}
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+
+	return s, nil
+}
+
+// Handler returns HTTP handler with all registered routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndex)
+	mux.HandleFunc("GET /validate", s.handleValidate)
+	mux.HandleFunc("GET /generate/physical", s.handleGenerate(inn.KindPhysical))
+	mux.HandleFunc("GET /generate/juridical", s.handleGenerate(inn.KindJuridical))
+	s.registerAPI(mux)
+	return s.logRequests(mux)
+}
+
+// Run starts the web server and stops it gracefully when the context is done.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.server.Addr, err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("web server started", "addr", listener.Addr().String())
+		errCh <- s.server.Serve(listener)
+	}()
+
+	select {
+	case err = <-errCh:
+		return fmt.Errorf("web server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err = s.server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shutdown web server: %w", err)
+	}
+
+	s.logger.Info("web server stopped")
+	return nil
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, _ *http.Request) {
+	s.render(w, &pageData{Count: DefaultCount})
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	value := r.URL.Query().Get("inn")
+	data := &pageData{INN: value, Count: DefaultCount, Checked: true, Strict: isStrict(r)}
+
+	err := validate(value, data.Strict)
+	data.Valid = err == nil
+	data.Result = inn.FmtResult(value, err)
+
+	if data.Valid {
+		data.Info, _ = inn.Parse(value)
+	} else if vErr, ok := inn.AsValidationError(err); ok {
+		data.Highlight = newHighlight(strings.TrimSpace(value), vErr.Position)
+		data.Suggestions = inn.Suggest(value)
+	}
+
+	s.render(w, data)
+}
+
+func (s *Server) handleGenerate(kind inn.Kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		data := &pageData{Kind: kind, Count: DefaultCount, Region: query.Get("region"), Office: query.Get("office")}
+
+		values, err := generateINNs(query, kind)
+		if err != nil {
+			code := http.StatusBadRequest
+			data.Error = err.Error()
+
+			if !isRequestError(err) {
+				s.logger.Error("failed to generate INN", "kind", kind, "error", err)
+				code, data.Error = http.StatusInternalServerError, "failed to generate INN"
+			}
+
+			w.WriteHeader(code)
+			s.render(w, data)
+			return
+		}
+
+		data.Count = len(values)
+		data.Generated = values
+		s.render(w, data)
+	}
+}
+
+func (s *Server) render(w http.ResponseWriter, data *pageData) {
+	data.MaxCount = MaxCount
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := s.tpl.ExecuteTemplate(w, "index.html", data); err != nil {
+		s.logger.Error("failed to render template", "error", err)
+	}
+}
+
+func (s *Server) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		s.logger.Debug("request", "method", r.Method, "path", r.URL.Path, "duration", time.Since(start))
+	})
+}
+
+// parseCount parses a count of INNs to generate, empty value means DefaultCount.
+func parseCount(value string) (int, error) {
+	if value == "" {
+		return DefaultCount, nil
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrInvalidCount, value)
+	}
+
+	if count < 1 || count > MaxCount {
+		return 0, fmt.Errorf("%w: %d is out of range [1, %d]", ErrInvalidCount, count, MaxCount)
+	}
+
+	return count, nil
+}
+
+// isStrict returns true if the request requires strict validation.
+func isStrict(r *http.Request) bool {
+	strict, err := strconv.ParseBool(r.URL.Query().Get("strict"))
+	return err == nil && strict
+}
+
+// validate checks the INN, strict mode also rejects tax office codes which do not exist.
+func validate(value string, strict bool) error {
+	validator := inn.NewValidator(value, 0)
+	if strict {
+		return validator.ValidateStrict()
+	}
+	return validator.Validate()
+}
+
+// parseCode parses an optional region or tax office code (1-99), empty value means 0 (random).
+func parseCode(value, name string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s %q is not a number", ErrInvalidCode, name, value)
+	}
+
+	if code < 1 || code > maxCode {
+		return 0, fmt.Errorf("%w: %s %d is out of range [1, %d]", ErrInvalidCode, name, code, maxCode)
+	}
+
+	return code, nil
+}
+
+// generateINNs generates INNs of the kind using count, region and office query parameters.
+func generateINNs(query url.Values, kind inn.Kind) ([]string, error) {
+	count, err := parseCount(query.Get("count"))
+	if err != nil {
+		return nil, err
+	}
+
+	options := []inn.Option{inn.WithKind(kind)}
+
+	region, err := parseCode(query.Get("region"), "region")
+	if err != nil {
+		return nil, err
+	}
+	if region != 0 {
+		options = append(options, inn.WithRegion(region))
+	}
+
+	office, err := parseCode(query.Get("office"), "tax office")
+	if err != nil {
+		return nil, err
+	}
+	if office != 0 {
+		options = append(options, inn.WithTaxOffice(office))
+	}
+
+	generator, err := inn.NewGenerator(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, count)
+	for range count {
+		value, genErr := generator.Generate()
+		if genErr != nil {
+			return nil, genErr
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// isRequestError returns true if the error is caused by invalid request parameters.
+func isRequestError(err error) bool {
+	return errors.Is(err, ErrInvalidCount) || errors.Is(err, ErrInvalidCode) || errors.Is(err, inn.ErrGeneratorOption)
+}
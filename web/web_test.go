@@ -0,0 +1,313 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/z0rr0/inngen/inn"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	s, err := NewServer("127.0.0.1:0", slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("NewServer() error = %v", err)
+	}
+	return s
+}
+
+func doRequest(t *testing.T, handler http.Handler, method, target string) (int, string) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	body, err := io.ReadAll(rec.Result().Body)
+	if err != nil {
+		t.Fatalf("failed to read response body: %v", err)
+	}
+	return rec.Code, string(body)
+}
+
+func TestServer_Handler(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		wantCode       int
+		wantSubstrings []string
+	}{
+		{
+			name:           "index page",
+			method:         http.MethodGet,
+			target:         "/",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"Validate INN", "/generate/physical", "/generate/juridical"},
+		},
+		{
+			name:     "unknown page",
+			method:   http.MethodGet,
+			target:   "/unknown",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "method not allowed",
+			method:   http.MethodPost,
+			target:   "/validate",
+			wantCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "valid juridical INN",
+			method:         http.MethodGet,
+			target:         "/validate?inn=7707083893",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"INN 7707083893 is valid (juridical person)", `class="valid"`, "ИФНС России № 7 по г. Москве"},
+		},
+		{
+			name:           "valid physical INN",
+			method:         http.MethodGet,
+			target:         "/validate?inn=500100732259",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"INN 500100732259 is valid (physical person)"},
+		},
+		{
+			name:           "invalid INN",
+			method:         http.MethodGet,
+			target:         "/validate?inn=7707083892",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"INN 7707083892 invalid", `class="invalid"`, "770708389<mark>2</mark>", "Did you mean", "/validate?inn=7707083893"},
+		},
+		{
+			name:           "highlight invalid character",
+			method:         http.MethodGet,
+			target:         "/validate?inn=77070X3893",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"invalid INN format", "77070<mark>X</mark>3893"},
+		},
+		{
+			name:           "strict unknown tax office",
+			method:         http.MethodGet,
+			target:         "/validate?inn=7799000012&strict=true",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"unknown INN tax office", `class="invalid"`, "checked"},
+		},
+		{
+			name:           "escaped INN",
+			method:         http.MethodGet,
+			target:         "/validate?inn=%3Cb%3E",
+			wantCode:       http.StatusOK,
+			wantSubstrings: []string{"&lt;b&gt;"},
+		},
+		{
+			name:           "invalid count",
+			method:         http.MethodGet,
+			target:         "/generate/physical?count=abc",
+			wantCode:       http.StatusBadRequest,
+			wantSubstrings: []string{"invalid count"},
+		},
+		{
+			name:           "count out of range",
+			method:         http.MethodGet,
+			target:         "/generate/juridical?count=1000",
+			wantCode:       http.StatusBadRequest,
+			wantSubstrings: []string{"out of range"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, tt.method, tt.target)
+			if code != tt.wantCode {
+				t.Errorf("status code = %d, want %d", code, tt.wantCode)
+			}
+
+			for _, substr := range tt.wantSubstrings {
+				if !strings.Contains(body, substr) {
+					t.Errorf("body does not contain %q", substr)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_HandleGenerate(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+	itemRegexp := regexp.MustCompile(`<li>(\d+)</li>`)
+
+	tests := []struct {
+		name   string
+		target string
+		count  int
+		length int
+	}{
+		{name: "physical default count", target: "/generate/physical", count: DefaultCount, length: inn.PhysicalLength},
+		{name: "physical custom count", target: "/generate/physical?count=3", count: 3, length: inn.PhysicalLength},
+		{name: "juridical default count", target: "/generate/juridical", count: DefaultCount, length: inn.JuridicalLength},
+		{name: "juridical max count", target: "/generate/juridical?count=100", count: MaxCount, length: inn.JuridicalLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, http.MethodGet, tt.target)
+			if code != http.StatusOK {
+				t.Fatalf("status code = %d, want %d", code, http.StatusOK)
+			}
+
+			matches := itemRegexp.FindAllStringSubmatch(body, -1)
+			if n := len(matches); n != tt.count {
+				t.Fatalf("generated %d INNs, want %d", n, tt.count)
+			}
+
+			for _, m := range matches {
+				if err := inn.NewValidator(m[1], tt.length).Validate(); err != nil {
+					t.Errorf("generated INN %s is invalid: %v", m[1], err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewHighlight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		position int
+		want     *highlight
+	}{
+		{name: "first", value: "123", position: 1, want: &highlight{Char: "1", After: "23"}},
+		{name: "middle", value: "123", position: 2, want: &highlight{Before: "1", Char: "2", After: "3"}},
+		{name: "last", value: "123", position: 3, want: &highlight{Before: "12", Char: "3"}},
+		{name: "unicode", value: "1Я3", position: 2, want: &highlight{Before: "1", Char: "Я", After: "3"}},
+		{name: "zero position", value: "123", position: 0},
+		{name: "out of range", value: "123", position: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newHighlight(tt.value, tt.position)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("newHighlight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr error
+	}{
+		{name: "empty", value: "", want: DefaultCount},
+		{name: "one", value: "1", want: 1},
+		{name: "max", value: "100", want: MaxCount},
+		{name: "zero", value: "0", wantErr: ErrInvalidCount},
+		{name: "negative", value: "-1", wantErr: ErrInvalidCount},
+		{name: "too big", value: "101", wantErr: ErrInvalidCount},
+		{name: "not a number", value: "ten", wantErr: ErrInvalidCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseCount(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("parseCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	t.Parallel()
+
+	s := newTestServer(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.Run(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Errorf("Run() error = %v, want nil", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Run() did not stop after context cancellation")
+	}
+}
+
+func TestServer_HandleGenerateRegion(t *testing.T) {
+	t.Parallel()
+	handler := newTestServer(t).Handler()
+	itemRegexp := regexp.MustCompile(`<li>(\d+)</li>`)
+
+	tests := []struct {
+		name       string
+		target     string
+		wantCode   int
+		wantPrefix string
+	}{
+		{name: "physical region", target: "/generate/physical?region=77", wantCode: http.StatusOK, wantPrefix: "77"},
+		{name: "juridical region and office", target: "/generate/juridical?region=78&office=12", wantCode: http.StatusOK, wantPrefix: "7812"},
+		{name: "region with leading zero", target: "/generate/juridical?region=05", wantCode: http.StatusOK, wantPrefix: "05"},
+		{name: "office without region", target: "/generate/juridical?office=12", wantCode: http.StatusBadRequest},
+		{name: "invalid region", target: "/generate/physical?region=abc", wantCode: http.StatusBadRequest},
+		{name: "region out of range", target: "/generate/physical?region=100", wantCode: http.StatusBadRequest},
+		{name: "zero region", target: "/generate/physical?region=0", wantCode: http.StatusBadRequest},
+		{name: "zero office", target: "/generate/juridical?region=77&office=00", wantCode: http.StatusBadRequest},
+		{name: "api zero region", target: "/api/v1/generate/juridical?region=0", wantCode: http.StatusBadRequest},
+		{name: "api region", target: "/api/v1/generate/juridical?region=50&office=1", wantCode: http.StatusOK},
+		{name: "api invalid office", target: "/api/v1/generate/juridical?region=50&office=x", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, body := doRequest(t, handler, http.MethodGet, tt.target)
+			if code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d: %s", code, tt.wantCode, body)
+			}
+
+			for _, m := range itemRegexp.FindAllStringSubmatch(body, -1) {
+				if !strings.HasPrefix(m[1], tt.wantPrefix) {
+					t.Errorf("generated INN %s, want prefix %s", m[1], tt.wantPrefix)
+				}
+			}
+		})
+	}
+}